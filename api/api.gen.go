@@ -65,12 +65,91 @@ const (
 	ErrUnknownError       InternalError = "UnknownError"
 )
 
+// Defines values for LeaderboardMetric.
+const (
+	LeaderboardMetricKd          LeaderboardMetric = "kd"
+	LeaderboardMetricTrialsWins  LeaderboardMetric = "trialsWins"
+	LeaderboardMetricWeaponKills LeaderboardMetric = "weaponKills"
+	LeaderboardMetricWinRate     LeaderboardMetric = "winRate"
+)
+
+// Defines values for LeaderboardScope.
+const (
+	LeaderboardScopeFireteam LeaderboardScope = "fireteam"
+	LeaderboardScopeFriends  LeaderboardScope = "friends"
+	LeaderboardScopeGroup    LeaderboardScope = "group"
+)
+
+// Defines values for LoadoutRanking.
+const (
+	LoadoutRankingBayesianKd        LoadoutRanking = "bayesianKd"
+	LoadoutRankingKd                LoadoutRanking = "kd"
+	LoadoutRankingRating            LoadoutRanking = "rating"
+	LoadoutRankingWinRateLowerBound LoadoutRanking = "winRateLowerBound"
+)
+
+// Defines values for MetaWindow.
+const (
+	MetaWindowDay   MetaWindow = "day"
+	MetaWindowMonth MetaWindow = "month"
+	MetaWindowWeek  MetaWindow = "week"
+)
+
+// Defines values for RollupType.
+const (
+	RollupTypeCharacter RollupType = "character"
+	RollupTypeSnapshot  RollupType = "snapshot"
+)
+
 // Defines values for SessionStatus.
 const (
 	SessionComplete SessionStatus = "complete"
 	SessionPending  SessionStatus = "pending"
 )
 
+// Defines values for ShareType.
+const (
+	ShareTypeSession  ShareType = "session"
+	ShareTypeSnapshot ShareType = "snapshot"
+)
+
+// Defines values for SnapshotNameStyle.
+const (
+	SnapshotNameStyleDescriptive SnapshotNameStyle = "descriptive"
+	SnapshotNameStyleMeme        SnapshotNameStyle = "meme"
+)
+
+// Defines values for SnapshotSort.
+const (
+	SnapshotSortCreatedAt   SnapshotSort = "createdAt"
+	SnapshotSortLastUsed    SnapshotSort = "lastUsed"
+	SnapshotSortPerformance SnapshotSort = "performance"
+	SnapshotSortRating      SnapshotSort = "rating"
+)
+
+// Defines values for StreakType.
+const (
+	StreakLoss StreakType = "loss"
+	StreakWin  StreakType = "win"
+)
+
+// Defines values for TrendBucket.
+const (
+	TrendBucketDay     TrendBucket = "day"
+	TrendBucketRolling TrendBucket = "rolling"
+	TrendBucketSession TrendBucket = "session"
+	TrendBucketWeek    TrendBucket = "week"
+)
+
+// Defines values for TrialsCardStatus.
+const (
+	TrialsCardActive   TrialsCardStatus = "active"
+	TrialsCardComplete TrialsCardStatus = "complete"
+	TrialsCardFailed   TrialsCardStatus = "failed"
+	TrialsCardFlawless TrialsCardStatus = "flawless"
+	TrialsCardReset    TrialsCardStatus = "reset"
+)
+
 // Defines values for GetSessionsParamsStatus.
 const (
 	GetSessionsParamsStatusSessionRequestComplete GetSessionsParamsStatus = "complete"
@@ -83,6 +162,24 @@ const (
 	GetUserSessionsParamsStatusSessionRequestPending  GetUserSessionsParamsStatus = "pending"
 )
 
+// AbilityKills Kills made with abilities rather than weapons. Shares are out of every kill, so totalShare is how much of a player's damage output comes from their subclass build.
+type AbilityKills struct {
+	// AbilityKills Kills with class and other abilities
+	AbilityKills int     `json:"abilityKills"`
+	AbilityShare float64 `json:"abilityShare"`
+	GrenadeKills int     `json:"grenadeKills"`
+	GrenadeShare float64 `json:"grenadeShare"`
+	Kills        int     `json:"kills"`
+	Matches      int     `json:"matches"`
+	MeleeKills   int     `json:"meleeKills"`
+	MeleeShare   float64 `json:"meleeShare"`
+	SuperKills   int     `json:"superKills"`
+	SuperShare   float64 `json:"superShare"`
+
+	// TotalShare Share of kills made with any ability
+	TotalShare float64 `json:"totalShare"`
+}
+
 // ActivityHistory defines model for ActivityHistory.
 type ActivityHistory struct {
 	Activity string `firestore:"activity" json:"activity"`
@@ -113,15 +210,21 @@ type ActivityMode string
 
 // Aggregate defines model for Aggregate.
 type Aggregate struct {
-	ActivityDetails ActivityHistory                `firestore:"activityHistory" json:"activityDetails"`
-	ActivityID      string                         `firestore:"activityId" json:"activityId"`
-	CharacterIds    []string                       `firestore:"characterIds" json:"characterIds"`
-	CreatedAt       time.Time                      `firestore:"createdAt" json:"createdAt"`
-	ID              string                         `firestore:"id" json:"id"`
-	Performance     map[string]InstancePerformance `firestore:"performance" json:"performance"`
-	SessionIds      []string                       `firestore:"sessionIds" json:"sessionIds"`
-	SnapshotIds     []string                       `firestore:"snapshotIds" json:"snapshotIds"`
-	SnapshotLinks   map[string]SnapshotLink        `firestore:"snapshotLinks" json:"snapshotLinks"`
+	ActivityDetails ActivityHistory `firestore:"activityHistory" json:"activityDetails"`
+	ActivityID      string          `firestore:"activityId" json:"activityId"`
+	CharacterIds    []string        `firestore:"characterIds" json:"characterIds"`
+	CreatedAt       time.Time       `firestore:"createdAt" json:"createdAt"`
+	ID              string          `firestore:"id" json:"id"`
+
+	// LobbyStrength Estimate of how strong a match's lobby was, built from every player's stats in the post game report. A player's opponents are every team other than their own, or the whole lobby in modes without teams.
+	LobbyStrength *LobbyStrength                 `firestore:"lobbyStrength" json:"lobbyStrength,omitempty"`
+	Performance   map[string]InstancePerformance `firestore:"performance" json:"performance"`
+
+	// RollupLinks Snapshot ID each character's performance was last counted under in the stats rollups, keyed by character ID. An empty string means the character was counted without a snapshot. Used to keep rollups correct when the aggregate is re-linked.
+	RollupLinks   *map[string]string      `firestore:"rollupLinks" json:"rollupLinks,omitempty"`
+	SessionIds    []string                `firestore:"sessionIds" json:"sessionIds"`
+	SnapshotIds   []string                `firestore:"snapshotIds" json:"snapshotIds"`
+	SnapshotLinks map[string]SnapshotLink `firestore:"snapshotLinks" json:"snapshotLinks"`
 }
 
 // AuditField defines model for AuditField.
@@ -186,6 +289,9 @@ type Character struct {
 
 // CharacterSnapshot defines model for CharacterSnapshot.
 type CharacterSnapshot struct {
+	// Archived Archived snapshots are hidden from the default snapshot list but are kept so their aggregates stay linked
+	Archived *bool `firestore:"archived" json:"archived,omitempty"`
+
 	// CharacterID Id of the character being recorded
 	CharacterID string `firestore:"characterId" json:"characterId"`
 
@@ -195,6 +301,9 @@ type CharacterSnapshot struct {
 	// Description Description of the snapshot. Will be empty by default and added by the user later.
 	Description *string `firestore:"description" json:"description,omitempty"`
 
+	// Favorite Whether the user has marked the snapshot as a favorite
+	Favorite *bool `firestore:"favorite" json:"favorite,omitempty"`
+
 	// Hash Hash of all the items to give us a unique key
 	Hash string `firestore:"hash" json:"hash"`
 
@@ -205,8 +314,14 @@ type CharacterSnapshot struct {
 	Loadout Loadout `firestore:"loadout" json:"loadout"`
 
 	// Name Name of the snapshot, will probably be generated by default by the system but can be changed by a user
-	Name  string                `firestore:"name" json:"name"`
-	Stats *map[string]ClassStat `firestore:"stats" json:"stats,omitempty"`
+	Name string `firestore:"name" json:"name"`
+
+	// Rating Rating of the snapshot from its stats rollup. Not stored on the snapshot.
+	Rating *LoadoutRating        `firestore:"-" json:"rating,omitempty"`
+	Stats  *map[string]ClassStat `firestore:"stats" json:"stats,omitempty"`
+
+	// Tags User defined labels used to organize snapshots
+	Tags *[]string `firestore:"tags" json:"tags,omitempty"`
 
 	// UpdatedAt Timestamp for when the snapshot was last updated or when a history entry was made for it.
 	UpdatedAt time.Time `firestore:"updatedAt" json:"updatedAt"`
@@ -226,6 +341,69 @@ type ClassStat struct {
 	Value           int32  `firestore:"value" json:"value"`
 }
 
+// ClassStatAnalysis Class stat tiers and their performance in a single mode.
+type ClassStatAnalysis struct {
+	ActivityHash   int64   `json:"activityHash"`
+	DeathsPerMatch float64 `json:"deathsPerMatch"`
+
+	// Matches Matches in the mode played with a snapshot that recorded class stats
+	Matches int `json:"matches"`
+
+	// Mode Name of the activity
+	Mode string `json:"mode"`
+
+	// Recommendation The class stat tier with the highest win rate lower bound among tiers with enough matches, compared to the mode's baseline.
+	Recommendation *ClassStatRecommendation `json:"recommendation,omitempty"`
+	Stats          []ClassStatCorrelation   `json:"stats"`
+	WinRate        float64                  `json:"winRate"`
+}
+
+// ClassStatCorrelation Performance per tier of a single class stat, lowest tier first.
+type ClassStatCorrelation struct {
+	Name string `json:"name"`
+
+	// StatHash Hash of the stat definition, the key in a snapshot's stats
+	StatHash string          `json:"statHash"`
+	Tiers    []ClassStatTier `json:"tiers"`
+}
+
+// ClassStatRecommendation The class stat tier with the highest win rate lower bound among tiers with enough matches, compared to the mode's baseline.
+type ClassStatRecommendation struct {
+	DeathsPerMatch float64 `json:"deathsPerMatch"`
+
+	// DeathsPerMatchDifference Deaths per match minus the mode's baseline deaths per match
+	DeathsPerMatchDifference float64 `json:"deathsPerMatchDifference"`
+	Matches                  int     `json:"matches"`
+	MinValue                 int     `json:"minValue"`
+	Name                     string  `json:"name"`
+	StatHash                 string  `json:"statHash"`
+	Tier                     int     `json:"tier"`
+	WinRate                  float64 `json:"winRate"`
+
+	// WinRateDifference Win rate minus the mode's baseline win rate
+	WinRateDifference float64 `json:"winRateDifference"`
+
+	// WinRateLowerBound Lower bound of the 95% Wilson interval of the win rate
+	WinRateLowerBound float64 `json:"winRateLowerBound"`
+}
+
+// ClassStatTier Performance of the snapshots whose class stat fell within a tier.
+type ClassStatTier struct {
+	Deaths         int     `json:"deaths"`
+	DeathsPerMatch float64 `json:"deathsPerMatch"`
+	Kd             float64 `json:"kd"`
+	Kills          int     `json:"kills"`
+	Matches        int     `json:"matches"`
+
+	// MinValue Lowest stat value in the tier
+	MinValue int `json:"minValue"`
+
+	// Tier The stat value divided by 10, rounded down
+	Tier    int     `json:"tier"`
+	WinRate float64 `json:"winRate"`
+	Wins    int     `json:"wins"`
+}
+
 // Color defines model for Color.
 type Color struct {
 	Alpha int `firestore:"alpha" json:"alpha"`
@@ -234,6 +412,49 @@ type Color struct {
 	Red   int `firestore:"red" json:"red"`
 }
 
+// ComparisonSide Totals for one of the loadouts in a comparison.
+type ComparisonSide struct {
+	// AdjustedKd K/D scaled by how strong the loadout's lobbies were relative to the average lobby of both loadouts, so games against stronger opponents count for more
+	AdjustedKd float64 `json:"adjustedKd"`
+	Assists    int     `json:"assists"`
+	Deaths     int     `json:"deaths"`
+	Kd         float64 `json:"kd"`
+
+	// Kda (kills + assists) / deaths
+	Kda            float64 `json:"kda"`
+	Kills          int     `json:"kills"`
+	KillsPerMinute float64 `json:"killsPerMinute"`
+
+	// LobbyStrength Average efficiency of the opponents faced, over matches with a lobby estimate
+	LobbyStrength *float64 `json:"lobbyStrength,omitempty"`
+	Matches       int      `json:"matches"`
+	Name          string   `json:"name"`
+	SecondsPlayed int      `json:"secondsPlayed"`
+	SnapshotID    string   `json:"snapshotId"`
+	WinRate       float64  `json:"winRate"`
+	Wins          int      `json:"wins"`
+}
+
+// ComparisonTest Result of a two-sided significance test on the difference of a metric between loadout a and loadout b.
+type ComparisonTest struct {
+	// AdditionalGames Estimated games still needed on each loadout to detect the observed difference with 80% power. Zero once significant, unset when there's no difference or not enough games to estimate it.
+	AdditionalGames *int `json:"additionalGames,omitempty"`
+
+	// Difference Value for a minus the value for b
+	Difference float64 `json:"difference"`
+	PValue     float64 `json:"pValue"`
+
+	// Significant Whether the difference is significant at the 5% level
+	Significant bool `json:"significant"`
+}
+
+// ConfidenceInterval An estimate with its 95% confidence interval.
+type ConfidenceInterval struct {
+	Estimate float64 `json:"estimate"`
+	Lower    float64 `json:"lower"`
+	Upper    float64 `json:"upper"`
+}
+
 // ConfidenceLevel defines model for ConfidenceLevel.
 type ConfidenceLevel string
 
@@ -254,6 +475,76 @@ type DetailActivity struct {
 	Aggregate *Aggregate      `json:"aggregate,omitempty"`
 }
 
+// Digest Summary of a user's week, generated on a schedule. The markdown and html bodies render the same content for notification channels.
+type Digest struct {
+	// BestLoadout Loadout with the best K/D among the ones played at least three times
+	BestLoadout *DigestLoadout `firestore:"bestLoadout" json:"bestLoadout,omitempty"`
+	DisplayName string         `firestore:"displayName" json:"displayName"`
+	GeneratedAt time.Time      `firestore:"generatedAt" json:"generatedAt"`
+	Goals       []DigestGoal   `firestore:"goals" json:"goals"`
+	HTML        string         `firestore:"html" json:"html"`
+	ID          string         `firestore:"id" json:"id"`
+	Markdown    string         `firestore:"markdown" json:"markdown"`
+	NewWeapons  []DigestWeapon `firestore:"newWeapons" json:"newWeapons"`
+
+	// Previous Totals of the week before
+	Previous DigestTotals `firestore:"previous" json:"previous"`
+
+	// Sessions Sessions started during the week
+	Sessions  int          `firestore:"sessions" json:"sessions"`
+	Totals    DigestTotals `firestore:"totals" json:"totals"`
+	Trend     DigestTrend  `firestore:"trend" json:"trend"`
+	UserID    string       `firestore:"userId" json:"userId"`
+	WeekEnd   time.Time    `firestore:"weekEnd" json:"weekEnd"`
+	WeekStart time.Time    `firestore:"weekStart" json:"weekStart"`
+
+	// WorstLoadout Loadout with the worst K/D among the ones played at least three times
+	WorstLoadout *DigestLoadout `firestore:"worstLoadout" json:"worstLoadout,omitempty"`
+}
+
+// DigestGoal A weekly goal and how far the user got.
+type DigestGoal struct {
+	Achieved bool    `firestore:"achieved" json:"achieved"`
+	Name     string  `firestore:"name" json:"name"`
+	Target   float64 `firestore:"target" json:"target"`
+	Value    float64 `firestore:"value" json:"value"`
+}
+
+// DigestLoadout A loadout snapshot and how it performed over the week.
+type DigestLoadout struct {
+	Kd         float64 `firestore:"kd" json:"kd"`
+	Matches    int     `firestore:"matches" json:"matches"`
+	Name       string  `firestore:"name" json:"name"`
+	SnapshotID string  `firestore:"snapshotId" json:"snapshotId"`
+	WinRate    float64 `firestore:"winRate" json:"winRate"`
+}
+
+// DigestTotals Totals of a user's matches over a week, across every character.
+type DigestTotals struct {
+	Deaths  int     `firestore:"deaths" json:"deaths"`
+	Kd      float64 `firestore:"kd" json:"kd"`
+	Kills   int     `firestore:"kills" json:"kills"`
+	Matches int     `firestore:"matches" json:"matches"`
+	WinRate float64 `firestore:"winRate" json:"winRate"`
+	Wins    int     `firestore:"wins" json:"wins"`
+}
+
+// DigestTrend Change of the week's totals versus the previous week. Positive values are improvements.
+type DigestTrend struct {
+	Kd      float64 `firestore:"kd" json:"kd"`
+	Matches int     `firestore:"matches" json:"matches"`
+	WinRate float64 `firestore:"winRate" json:"winRate"`
+}
+
+// DigestWeapon A weapon the user got kills with this week but not in the weeks before.
+type DigestWeapon struct {
+	Hash    int64   `firestore:"hash" json:"hash"`
+	Icon    *string `firestore:"icon" json:"icon,omitempty"`
+	Kills   int     `firestore:"kills" json:"kills"`
+	Matches int     `firestore:"matches" json:"matches"`
+	Name    string  `firestore:"name" json:"name"`
+}
+
 // Display defines model for Display.
 type Display struct {
 	Description string  `firestore:"description" json:"description"`
@@ -270,9 +561,37 @@ type FireteamMember struct {
 	MembershipID string      `firestore:"membershipId" json:"membershipId"`
 }
 
+// FireteamStats Totals for a set of matches played with or without a fireteam.
+type FireteamStats struct {
+	Assists int     `json:"assists"`
+	Deaths  int     `json:"deaths"`
+	Kd      float64 `json:"kd"`
+	Kills   int     `json:"kills"`
+	Matches int     `json:"matches"`
+	WinRate float64 `json:"winRate"`
+	Wins    int     `json:"wins"`
+}
+
 // GameMode defines model for GameMode.
 type GameMode string
 
+// Group A named set of OneTrick users that compete on a shared leaderboard.
+type Group struct {
+	CreatedAt time.Time `firestore:"createdAt" json:"createdAt"`
+	ID        string    `firestore:"id" json:"id"`
+
+	// InvitedIDs Ids of the users invited by the owner that haven't accepted yet
+	InvitedIDs *[]string `firestore:"invitedIds" json:"invitedIds,omitempty"`
+
+	// MemberIDs Ids of the users in the group, always including the owner. Invited users are only added once they accept.
+	MemberIDs []string `firestore:"memberIds" json:"memberIds"`
+	Name      string   `firestore:"name" json:"name"`
+
+	// OwnerID Id of the user that created the group and can change it
+	OwnerID   string    `firestore:"ownerId" json:"ownerId"`
+	UpdatedAt time.Time `firestore:"updatedAt" json:"updatedAt"`
+}
+
 // GunStat defines model for GunStat.
 type GunStat struct {
 	Description string `firestore:"description" json:"description"`
@@ -332,9 +651,132 @@ type ItemSnapshot struct {
 	Name string `firestore:"name" json:"name"`
 }
 
+// Leaderboard defines model for Leaderboard.
+type Leaderboard struct {
+	From time.Time `json:"from"`
+
+	// Items Ranked users, best first
+	Items []LeaderboardEntry `json:"items"`
+
+	// Metric What a leaderboard ranks players by. `weaponKills` counts the kills of a single weapon and `trialsWins` counts the wins in Trials of Osiris.
+	Metric LeaderboardMetric `json:"metric"`
+
+	// Scope Who is ranked on a leaderboard. `friends` is everyone sharing a group with the user, `fireteam` is the user's current fireteam and the OneTrick players they frequently queue with, and `group` is a single named group.
+	Scope LeaderboardScope `json:"scope"`
+	To    time.Time        `json:"to"`
+}
+
+// LeaderboardEntry A user's place on a leaderboard, counted over every one of their characters.
+type LeaderboardEntry struct {
+	DisplayName string `json:"displayName"`
+
+	// Matches Matches counted for the user
+	Matches int `json:"matches"`
+
+	// Rank Position on the leaderboard, starting at 1. Tied users share a rank.
+	Rank   int    `json:"rank"`
+	UserID string `json:"userId"`
+
+	// Value Value of the ranked metric
+	Value float64 `json:"value"`
+}
+
+// LeaderboardMetric What a leaderboard ranks players by. `weaponKills` counts the kills of a single weapon and `trialsWins` counts the wins in Trials of Osiris.
+type LeaderboardMetric string
+
+// LeaderboardScope Who is ranked on a leaderboard. `friends` is everyone sharing a group with the user, `fireteam` is the user's current fireteam and the OneTrick players they frequently queue with, and `group` is a single named group.
+type LeaderboardScope string
+
 // Loadout All buckets that we currently care about, Kinetic, Energy, Heavy and Class for now. Each will be a key in the items.
 type Loadout map[string]ItemSnapshot
 
+// LoadoutComparison Head to head comparison of two loadouts of the same character.
+type LoadoutComparison struct {
+	// A Totals for one of the loadouts in a comparison.
+	A ComparisonSide `json:"a"`
+
+	// B Totals for one of the loadouts in a comparison.
+	B ComparisonSide `json:"b"`
+
+	// Kd Result of a two-sided significance test on the difference of a metric between loadout a and loadout b.
+	Kd ComparisonTest `json:"kd"`
+
+	// KillsPerMinute Result of a two-sided significance test on the difference of a metric between loadout a and loadout b.
+	KillsPerMinute ComparisonTest `json:"killsPerMinute"`
+
+	// WinRate Result of a two-sided significance test on the difference of a metric between loadout a and loadout b.
+	WinRate ComparisonTest `json:"winRate"`
+}
+
+// LoadoutConfidence How confident we are in a loadout's performance.
+type LoadoutConfidence struct {
+	// Kd An estimate with its 95% confidence interval.
+	Kd ConfidenceInterval `json:"kd"`
+
+	// Score Value the loadout was ranked by for the requested ranking method
+	Score float64 `json:"score"`
+
+	// WinRate An estimate with its 95% confidence interval.
+	WinRate ConfidenceInterval `json:"winRate"`
+}
+
+// LoadoutRanking How loadouts are ranked. kd is the raw K/D. bayesianKd shrinks each loadout's K/D toward the character's overall K/D, so loadouts with few games need more evidence to rank high. winRateLowerBound ranks by the lower bound of the Wilson score interval for the win rate. rating ranks by the conservative rating of the loadout, which isn't split by mode.
+type LoadoutRanking string
+
+// LoadoutRating Glicko rating of a loadout, updated after every match with the outcome in the order the matches were played. The opponents are rated from their efficiency in the lobby estimate relative to an average lobby, matches without an estimate are rated against opponents equal to the loadout. Deviation shrinks as more matches are played.
+type LoadoutRating struct {
+	// Conservative Rating minus twice the deviation, used to rank loadouts
+	Conservative float64 `firestore:"conservative" json:"conservative"`
+
+	// Deviation Rating deviation, the uncertainty of the rating
+	Deviation float64 `firestore:"deviation" json:"deviation"`
+	Matches   int     `firestore:"matches" json:"matches"`
+	Rating    float64 `firestore:"rating" json:"rating"`
+}
+
+// LobbyStrength Estimate of how strong a match's lobby was, built from every player's stats in the post game report. A player's opponents are every team other than their own, or the whole lobby in modes without teams.
+type LobbyStrength struct {
+	// Efficiency Average per player efficiency across the lobby
+	Efficiency float64 `firestore:"efficiency" json:"efficiency"`
+	Players    int     `firestore:"players" json:"players"`
+
+	// Score Average per player score across the lobby
+	Score float64 `firestore:"score" json:"score"`
+
+	// Teams Strength of each team keyed by team ID
+	Teams map[string]TeamStrength `firestore:"teams" json:"teams"`
+}
+
+// MapLoadout The best loadout on a map, ranked by K/D shrunk toward the character's K/D on the map so a single lucky game doesn't win.
+type MapLoadout struct {
+	Kd         float64 `json:"kd"`
+	Matches    int     `json:"matches"`
+	Name       string  `json:"name"`
+	SnapshotID string  `json:"snapshotId"`
+	WinRate    float64 `json:"winRate"`
+}
+
+// MapPerformance A character's performance on a single map.
+type MapPerformance struct {
+	Assists int `json:"assists"`
+
+	// BestLoadout The best loadout on a map, ranked by K/D shrunk toward the character's K/D on the map so a single lucky game doesn't win.
+	BestLoadout *MapLoadout `json:"bestLoadout,omitempty"`
+	Deaths      int         `json:"deaths"`
+	ImageURL    *string     `json:"imageUrl,omitempty"`
+	Kd          float64     `json:"kd"`
+	Kills       int         `json:"kills"`
+
+	// Location Name of the map
+	Location string `json:"location"`
+	Matches  int    `json:"matches"`
+
+	// ReferenceID Hash ID of the map's activity definition
+	ReferenceID int64   `json:"referenceId"`
+	WinRate     float64 `json:"winRate"`
+	Wins        int     `json:"wins"`
+}
+
 // Membership defines model for Membership.
 type Membership struct {
 	DisplayName string `firestore:"displayName" json:"displayName"`
@@ -342,6 +784,58 @@ type Membership struct {
 	Type        int64  `firestore:"type" json:"type"`
 }
 
+// MetaEntry Usage and performance of a weapon, or of every weapon sharing an archetype, among OneTrick players. A player's match counts as a use when the weapon got a kill in it.
+type MetaEntry struct {
+	// Hash Item hash of the weapon, not set for archetypes
+	Hash *int64  `firestore:"hash" json:"hash,omitempty"`
+	Icon *string `firestore:"icon" json:"icon,omitempty"`
+
+	// KillShare Share of every weapon kill made with it
+	KillShare float64 `firestore:"killShare" json:"killShare"`
+	Kills     int     `firestore:"kills" json:"kills"`
+
+	// Matches Player matches it was used in
+	Matches int    `firestore:"matches" json:"matches"`
+	Name    string `firestore:"name" json:"name"`
+
+	// Players Distinct characters that used it
+	Players int `firestore:"players" json:"players"`
+
+	// UsageShare Share of every player match it was used in
+	UsageShare float64 `firestore:"usageShare" json:"usageShare"`
+
+	// WinRate Win rate of the player matches it was used in
+	WinRate float64 `firestore:"winRate" json:"winRate"`
+	Wins    int     `firestore:"wins" json:"wins"`
+}
+
+// MetaReport What OneTrick players used and how it performed in a game mode over a window. Generated on a schedule and cached, so it can be up to a day old.
+type MetaReport struct {
+	// Archetypes Every weapon archetype used, most matches first
+	Archetypes []MetaEntry `firestore:"archetypes" json:"archetypes"`
+
+	// Exotics Most used exotic weapons, most matches first
+	Exotics     []MetaEntry `firestore:"exotics" json:"exotics"`
+	From        time.Time   `firestore:"from" json:"from"`
+	GameMode    GameMode    `firestore:"gameMode" json:"gameMode"`
+	GeneratedAt time.Time   `firestore:"generatedAt" json:"generatedAt"`
+	ID          string      `firestore:"id" json:"id"`
+
+	// Matches Player matches in the window, a match counts once per OneTrick character in it
+	Matches int `firestore:"matches" json:"matches"`
+
+	// Players Distinct characters that played in the window
+	Players int       `firestore:"players" json:"players"`
+	To      time.Time `firestore:"to" json:"to"`
+
+	// Weapons Most used weapons, most matches first
+	Weapons []MetaEntry `firestore:"weapons" json:"weapons"`
+	Window  MetaWindow  `firestore:"window" json:"window"`
+}
+
+// MetaWindow How far back a community meta report looks, ending when the report was generated.
+type MetaWindow string
+
 // OneTrickError Known errors for the one trick API
 type OneTrickError struct {
 	// Message User friendly description of the error
@@ -361,8 +855,46 @@ type Perk struct {
 	Name     string  `firestore:"name" json:"name"`
 }
 
+// PerkPerformance Performance across the matches a weapon was equipped with a given perk.
+type PerkPerformance struct {
+	// Deaths Total player deaths in those matches
+	Deaths int `json:"deaths"`
+
+	// Hash The hash ID of the perk plug
+	Hash int64   `json:"hash"`
+	Icon *string `json:"icon,omitempty"`
+	Kd   float64 `json:"kd"`
+
+	// Kills Total player kills in those matches
+	Kills int `json:"kills"`
+
+	// KillsPerMatch Weapon kills per match
+	KillsPerMatch float64 `json:"killsPerMatch"`
+
+	// Matches Sample size, the number of matches the weapon was equipped with the perk
+	Matches int    `json:"matches"`
+	Name    string `json:"name"`
+
+	// PrecisionKills Precision kills made with the weapon itself
+	PrecisionKills int `json:"precisionKills"`
+
+	// PrecisionRate Share of weapon kills that were precision kills, between 0 and 1
+	PrecisionRate float64 `json:"precisionRate"`
+
+	// Type Kind of socket the perk sits in, e.g. Trait, Barrel or Magazine
+	Type *string `json:"type,omitempty"`
+
+	// WeaponKills Kills made with the weapon itself
+	WeaponKills int     `json:"weaponKills"`
+	WinRate     float64 `json:"winRate"`
+	Wins        int     `json:"wins"`
+}
+
 // PlayerStats All Player Stats from a match that we currently care about
 type PlayerStats struct {
+	// AbilityKills Number of kills with class and other abilities in the match
+	AbilityKills *StatsValuePair `firestore:"abilityKills" json:"abilityKills,omitempty"`
+
 	// Assists Number of assists done in the match
 	Assists *StatsValuePair `firestore:"assists" json:"assists,omitempty"`
 
@@ -372,6 +904,9 @@ type PlayerStats struct {
 	// FireTeamID ID for the fireteam player was on. If the same as another player then they were together
 	FireTeamID *StatsValuePair `firestore:"fireTeamId" json:"fireTeamId,omitempty"`
 
+	// GrenadeKills Number of kills with grenades in the match
+	GrenadeKills *StatsValuePair `firestore:"grenadeKills" json:"grenadeKills,omitempty"`
+
 	// Kd ratio of kill / deaths in the match
 	Kd *StatsValuePair `firestore:"kd" json:"kd,omitempty"`
 
@@ -381,9 +916,15 @@ type PlayerStats struct {
 	// Kills Number of kills done in the match
 	Kills *StatsValuePair `firestore:"kills" json:"kills,omitempty"`
 
+	// MeleeKills Number of kills with melee abilities in the match
+	MeleeKills *StatsValuePair `firestore:"meleeKills" json:"meleeKills,omitempty"`
+
 	// Standing Win or lose in the match
 	Standing *StatsValuePair `firestore:"standing" json:"standing,omitempty"`
 
+	// SuperKills Number of kills with the super in the match
+	SuperKills *StatsValuePair `firestore:"superKills" json:"superKills,omitempty"`
+
 	// Team Id for the team the player was on this match
 	Team *StatsValuePair `firestore:"team" json:"team,omitempty"`
 
@@ -405,6 +946,75 @@ type Profile struct {
 	UniqueName   string      `json:"uniqueName"`
 }
 
+// RollupBucket Totals and weapon totals for a group of games.
+type RollupBucket struct {
+	// Activity Name of the activity of a mode bucket, as of its latest game
+	Activity *string `firestore:"activity" json:"activity,omitempty"`
+
+	// Totals Running totals of a player's games. The squared and product sums allow variance based statistics, like K/D confidence intervals, without re-reading every game.
+	Totals RollupTotals `firestore:"totals" json:"totals"`
+
+	// Weapons Weapon totals keyed by the weapon's reference ID
+	Weapons map[string]RollupWeapon `firestore:"weapons" json:"weapons"`
+}
+
+// RollupTotals Running totals of a player's games. The squared and product sums allow variance based statistics, like K/D confidence intervals, without re-reading every game.
+type RollupTotals struct {
+	AbilityKills int `firestore:"abilityKills" json:"abilityKills"`
+	Assists      int `firestore:"assists" json:"assists"`
+	Deaths       int `firestore:"deaths" json:"deaths"`
+
+	// DeathsSquared Sum of each game's deaths squared
+	DeathsSquared int `firestore:"deathsSquared" json:"deathsSquared"`
+	GrenadeKills  int `firestore:"grenadeKills" json:"grenadeKills"`
+	Kills         int `firestore:"kills" json:"kills"`
+
+	// KillsDeaths Sum of each game's kills multiplied by its deaths
+	KillsDeaths int `firestore:"killsDeaths" json:"killsDeaths"`
+
+	// KillsSquared Sum of each game's kills squared
+	KillsSquared  int `firestore:"killsSquared" json:"killsSquared"`
+	Matches       int `firestore:"matches" json:"matches"`
+	MeleeKills    int `firestore:"meleeKills" json:"meleeKills"`
+	SecondsPlayed int `firestore:"secondsPlayed" json:"secondsPlayed"`
+	SuperKills    int `firestore:"superKills" json:"superKills"`
+	Wins          int `firestore:"wins" json:"wins"`
+}
+
+// RollupType Whether a rollup totals every game of a character or the games of a single snapshot.
+type RollupType string
+
+// RollupWeapon Running totals of a single weapon.
+type RollupWeapon struct {
+	Display        *Display `firestore:"display" json:"display,omitempty"`
+	Kills          int      `firestore:"kills" json:"kills"`
+	Matches        int      `firestore:"matches" json:"matches"`
+	PrecisionKills int      `firestore:"precisionKills" json:"precisionKills"`
+	ReferenceID    int64    `firestore:"referenceId" json:"referenceId"`
+	Wins           int      `firestore:"wins" json:"wins"`
+}
+
+// Schedule A character's matches bucketed by the local hour and day of the week they were played. Every bucket is present, including those without matches.
+type Schedule struct {
+	Hours    []ScheduleBucket `json:"hours"`
+	Timezone string           `json:"timezone"`
+	Weekdays []ScheduleBucket `json:"weekdays"`
+}
+
+// ScheduleBucket Performance in the matches played during one hour of the day or one day of the week.
+type ScheduleBucket struct {
+	Assists int `json:"assists"`
+
+	// Bucket Hour of the day from 0 to 23, or day of the week from 0 (Sunday) to 6
+	Bucket  int     `json:"bucket"`
+	Deaths  int     `json:"deaths"`
+	Kd      float64 `json:"kd"`
+	Kills   int     `json:"kills"`
+	Matches int     `json:"matches"`
+	WinRate float64 `json:"winRate"`
+	Wins    int     `json:"wins"`
+}
+
 // SearchUserResult defines model for SearchUserResult.
 type SearchUserResult struct {
 	AlternateNames      []string `json:"alternateNames"`
@@ -436,6 +1046,32 @@ type Session struct {
 // SessionStatus defines model for Session.Status.
 type SessionStatus string
 
+// ShareLink defines model for ShareLink.
+type ShareLink struct {
+	CreatedAt time.Time `firestore:"createdAt" json:"createdAt"`
+
+	// ExpiresAt Optional time after which the link stops working
+	ExpiresAt *time.Time `firestore:"expiresAt" json:"expiresAt,omitempty"`
+
+	// ID Unguessable token used in the public URL
+	ID string `firestore:"id" json:"id"`
+
+	// ResourceID ID of the snapshot or session being shared
+	ResourceID string `firestore:"resourceId" json:"resourceId"`
+
+	// RevokedAt Set when the owner revokes the link
+	RevokedAt *time.Time `firestore:"revokedAt" json:"revokedAt,omitempty"`
+
+	// Type Kind of resource a share link points to
+	Type ShareType `firestore:"type" json:"type"`
+
+	// UserID Id of the user that created the link
+	UserID string `firestore:"userId" json:"userId"`
+}
+
+// ShareType Kind of resource a share link points to
+type ShareType string
+
 // SnapshotLink defines model for SnapshotLink.
 type SnapshotLink struct {
 	CharacterID      string           `firestore:"characterId" json:"characterId"`
@@ -453,6 +1089,12 @@ type SnapshotLink struct {
 	SnapshotID *string `firestore:"snapshotId" json:"snapshotId,omitempty"`
 }
 
+// SnapshotNameStyle How the system names new snapshots. meme picks a random PvP flavoured name, descriptive builds one from the subclass and weapons, e.g. "Solar Hunter: Ace of Spades + Fusion".
+type SnapshotNameStyle string
+
+// SnapshotSort Order to return snapshots in. createdAt is newest first, lastUsed is the most recently seen snapshot first, performance is highest K/D first and rating is highest conservative rating first.
+type SnapshotSort string
+
 // Socket defines model for Socket.
 type Socket struct {
 	Description string  `firestore:"description" json:"description"`
@@ -474,6 +1116,71 @@ type Socket struct {
 // Stats defines model for Stats.
 type Stats map[string]GunStat
 
+// StatsFilter Narrows down the matches counted by a metrics endpoint. Every set condition must hold, unset conditions are ignored, and the list conditions match any of their values. Passed as a deep object, e.g. `filter[mapHashes][0]=123&filter[from]=2024-01-01T00:00:00Z`.
+type StatsFilter struct {
+	// From Only include matches played at or after this time
+	From *time.Time `json:"from,omitempty"`
+
+	// MapHashes Hashes of the maps to include
+	MapHashes *[]int64 `json:"mapHashes,omitempty"`
+
+	// MaxLobbyStrength Only include matches where the opponents' average efficiency was at most this value. Matches without a lobby estimate are excluded when set.
+	MaxLobbyStrength *float64 `json:"maxLobbyStrength,omitempty"`
+
+	// MinLobbyStrength Only include matches where the opponents' average efficiency was at least this value. Matches without a lobby estimate are excluded when set.
+	MinLobbyStrength *float64 `json:"minLobbyStrength,omitempty"`
+
+	// MinimumSeconds Only include matches the character played for at least this many seconds
+	MinimumSeconds *int `json:"minimumSeconds,omitempty"`
+
+	// ModeHashes Hashes of the activities (playlists) to include, e.g. Control or Trials of Osiris
+	ModeHashes *[]int64 `json:"modeHashes,omitempty"`
+
+	// SessionIDs Only include matches recorded during these sessions
+	SessionIDs *[]string `json:"sessionIds,omitempty"`
+
+	// SnapshotIDs Only include matches linked to these snapshots
+	SnapshotIDs *[]string `json:"snapshotIds,omitempty"`
+
+	// Tags Only include matches linked to a snapshot with one of these tags
+	Tags *[]string `json:"tags,omitempty"`
+
+	// To Only include matches played before this time
+	To *time.Time `json:"to,omitempty"`
+
+	// WeaponHashes Only include matches where one of these weapons got a kill
+	WeaponHashes *[]int64 `json:"weaponHashes,omitempty"`
+}
+
+// StatsRollup Incrementally maintained totals of a character's games, or of the games played with one of their snapshots. Read by the stats endpoints instead of every aggregate.
+type StatsRollup struct {
+	CharacterID string `firestore:"characterId" json:"characterId"`
+	ID          string `firestore:"id" json:"id"`
+
+	// LastAggregateCreatedAt Creation time of the newest aggregate counted, used to catch up on new aggregates
+	LastAggregateCreatedAt *time.Time `firestore:"lastAggregateCreatedAt" json:"lastAggregateCreatedAt,omitempty"`
+
+	// LastPlayedAt Period of the latest game counted. Snapshot ratings are replayed in the order the games were played, an older game arriving later marks the rollups stale.
+	LastPlayedAt *time.Time `firestore:"lastPlayedAt" json:"lastPlayedAt,omitempty"`
+
+	// Modes Totals per activity, keyed by activityHistory.activityHash
+	Modes map[string]RollupBucket `firestore:"modes" json:"modes"`
+
+	// Overall Totals and weapon totals for a group of games.
+	Overall RollupBucket `firestore:"overall" json:"overall"`
+
+	// Rating Rating of the snapshot, only kept on snapshot rollups
+	Rating     *LoadoutRating `firestore:"rating" json:"rating,omitempty"`
+	SnapshotID *string        `firestore:"snapshotId" json:"snapshotId,omitempty"`
+
+	// Stale Set when a game couldn't be counted on its own, e.g. it was re-linked or played before the snapshot's latest game. Stale rollups aren't served or synced until the admin rebuild regenerates them.
+	Stale *bool `firestore:"stale" json:"stale,omitempty"`
+
+	// Type Whether a rollup totals every game of a character or the games of a single snapshot.
+	Type      RollupType `firestore:"type" json:"type"`
+	UpdatedAt time.Time  `firestore:"updatedAt" json:"updatedAt"`
+}
+
 // StatsValuePair defines model for StatsValuePair.
 type StatsValuePair struct {
 	// DisplayValue Localized formatted version of the value.
@@ -483,6 +1190,36 @@ type StatsValuePair struct {
 	Value *float64 `firestore:"value" json:"value,omitempty"`
 }
 
+// Streak A run of consecutive wins or losses.
+type Streak struct {
+	// End Period of the last match of the streak
+	End    time.Time `json:"end"`
+	Length int       `json:"length"`
+
+	// Start Period of the first match of the streak
+	Start time.Time  `json:"start"`
+	Type  StreakType `json:"type"`
+}
+
+// StreakSummary Win and loss streaks over a set of matches, along with the player's tilt status.
+type StreakSummary struct {
+	// Current A run of consecutive wins or losses.
+	Current *Streak `json:"current,omitempty"`
+
+	// LongestLoss A run of consecutive wins or losses.
+	LongestLoss *Streak `json:"longestLoss,omitempty"`
+
+	// LongestWin A run of consecutive wins or losses.
+	LongestWin *Streak `json:"longestWin,omitempty"`
+	Matches    int     `json:"matches"`
+
+	// Tilt Compares the K/D of the last matches with the player's baseline. The player is tilted when a full window of recent matches falls below threshold times the baseline K/D.
+	Tilt TiltStatus `json:"tilt"`
+}
+
+// StreakType defines model for StreakType.
+type StreakType string
+
 // Team defines model for Team.
 type Team struct {
 	ID       string  `json:"id"`
@@ -491,50 +1228,265 @@ type Team struct {
 	TeamName *string `json:"teamName,omitempty"`
 }
 
-// UniqueStatValue defines model for UniqueStatValue.
-type UniqueStatValue struct {
-	// ActivityID When a stat represents the best, most, longest, fastest or some other personal best, the actual activity ID where that personal best was established is available on this property.
-	ActivityID *int64 `firestore:"activityId" json:"activityId"`
+// TeamStrength Average stats of the players on one side of a match.
+type TeamStrength struct {
+	// Efficiency Average per player efficiency, (kills + assists) / deaths
+	Efficiency float64 `firestore:"efficiency" json:"efficiency"`
+	Players    int     `firestore:"players" json:"players"`
 
-	// Basic Basic stat value.
-	Basic StatsValuePair `firestore:"basic" json:"basic"`
-	Name  *string        `firestore:"name" json:"name,omitempty"`
+	// Score Average per player score
+	Score float64 `firestore:"score" json:"score"`
+}
 
-	// Pga Per game average for the statistic, if applicable
-	Pga *StatsValuePair `firestore:"pga" json:"pga,omitempty"`
+// Teammate A OneTrick character the player queued with, and how the player performed in those matches.
+type Teammate struct {
+	CharacterID string  `json:"characterId"`
+	DisplayName *string `json:"displayName,omitempty"`
 
-	// Weighted Weighted value of the stat if a weight greater than 1 has been assigned.
-	Weighted *StatsValuePair `firestore:"weighted" json:"weighted,omitempty"`
-}
+	// Together Totals for a set of matches played with or without a fireteam.
+	Together FireteamStats `json:"together"`
 
-// User defines model for User.
-type User struct {
-	CharacterIDs        []string     `firestore:"characterIds" json:"characterIds"`
-	CreatedAt           time.Time    `firestore:"createdAt" json:"createdAt"`
-	DisplayName         string       `firestore:"displayName" json:"displayName"`
-	ID                  string       `firestore:"id" json:"id"`
-	MemberID            string       `firestore:"memberId" json:"memberId"`
-	Memberships         []Membership `json:"memberships"`
-	PrimaryMembershipID string       `firestore:"primaryMembershipId" json:"primaryMembershipId"`
-	UniqueName          string       `firestore:"uniqueName" json:"uniqueName"`
+	// UserID ID of the OneTrick user owning the character, when known
+	UserID *string `json:"userId,omitempty"`
 }
 
-// WeaponInstanceMetrics defines model for WeaponInstanceMetrics.
-type WeaponInstanceMetrics struct {
-	Display *Display `firestore:"display" json:"display,omitempty"`
+// TiltStatus Compares the K/D of the last matches with the player's baseline. The player is tilted when a full window of recent matches falls below threshold times the baseline K/D.
+type TiltStatus struct {
+	BaselineKd float64 `json:"baselineKd"`
 
-	// ItemProperties The response object for retrieving an individual instanced item. None of these components are relevant for an item that doesn't have an "itemInstanceId": for those, get your information from the DestinyInventoryDefinition.
-	ItemProperties *ItemProperties `firestore:"itemProperties" json:"properties,omitempty"`
+	// Matches Number of recent matches available, at most window
+	Matches  int     `json:"matches"`
+	RecentKd float64 `json:"recentKd"`
 
-	// ReferenceID The hash ID of the item definition that describes the weapon.
-	ReferenceID *int64                      `firestore:"referenceId" json:"referenceId,omitempty"`
-	Stats       *map[string]UniqueStatValue `firestore:"stats" json:"stats,omitempty"`
+	// Threshold Fraction of the baseline K/D the recent K/D has to stay above
+	Threshold float64 `json:"threshold"`
+	Tilted    bool    `json:"tilted"`
+
+	// Window Number of recent matches compared
+	Window int `json:"window"`
 }
 
-// XMembershipID defines model for X-Membership-ID.
-type XMembershipID = string
+// TrendBucket How matches are grouped into points of a trend. day and week use UTC calendar days and weeks starting Monday, session uses the session the match was checked in to, and rolling is a moving window of the last N matches.
+type TrendBucket string
 
-// XUserID defines model for X-User-ID.
+// TrendPoint Performance over a group of matches in a trend.
+type TrendPoint struct {
+	Assists int `json:"assists"`
+
+	// BungieKda Bungie's KDA, (kills + assists / 2) / deaths
+	BungieKda float64 `json:"bungieKda"`
+	Deaths    int     `json:"deaths"`
+
+	// Efficiency (kills + assists) / deaths
+	Efficiency float64 `json:"efficiency"`
+
+	// End End of the bucket, or the period of the last match in a rolling window
+	End time.Time `json:"end"`
+
+	// Kd kills / deaths
+	Kd float64 `json:"kd"`
+
+	// Kda (kills + assists) / deaths, the KDA returned by every other endpoint
+	Kda   float64 `json:"kda"`
+	Kills int     `json:"kills"`
+
+	// LobbyStrength Average efficiency of the opponents faced, over matches with a lobby estimate
+	LobbyStrength *float64 `json:"lobbyStrength,omitempty"`
+	Matches       int      `json:"matches"`
+
+	// SessionID Set when bucketing by session
+	SessionID *string `json:"sessionId,omitempty"`
+
+	// Start Start of the bucket, or the period of the first match in a rolling window
+	Start   time.Time `json:"start"`
+	WinRate float64   `json:"winRate"`
+	Wins    int       `json:"wins"`
+}
+
+// TrialsCard A run of Trials matches on a single card.
+type TrialsCard struct {
+	Deaths int `json:"deaths"`
+
+	// End Period of the last match on the card
+	End    time.Time `json:"end"`
+	Kd     float64   `json:"kd"`
+	Kills  int       `json:"kills"`
+	Losses int       `json:"losses"`
+
+	// SnapshotIDs Loadouts used on the card, in the order they were first used
+	SnapshotIDs []string `json:"snapshotIds"`
+
+	// Start Period of the first match on the card
+	Start time.Time `json:"start"`
+
+	// Status How a Trials card ended. Flawless cards reached seven wins without a loss, complete cards reached seven wins with losses, failed cards took three losses and reset cards were left open when the weekly reset hit.
+	Status TrialsCardStatus `json:"status"`
+	Wins   int              `json:"wins"`
+}
+
+// TrialsCardLoadout How often a loadout was used on cards with the same outcome.
+type TrialsCardLoadout struct {
+	// Cards Cards the loadout was used on
+	Cards int `json:"cards"`
+
+	// Matches Matches played with the loadout on those cards
+	Matches int `json:"matches"`
+
+	// Name Name of the snapshot, missing when it no longer exists
+	Name       *string `json:"name,omitempty"`
+	SnapshotID string  `json:"snapshotId"`
+	Wins       int     `json:"wins"`
+}
+
+// TrialsCardStatus How a Trials card ended. Flawless cards reached seven wins without a loss, complete cards reached seven wins with losses, failed cards took three losses and reset cards were left open when the weekly reset hit.
+type TrialsCardStatus string
+
+// TrialsCardSummary A character's Trials cards, with the loadouts used on flawless and failed cards.
+type TrialsCardSummary struct {
+	// AverageWins Average wins of the finished cards
+	AverageWins float64 `json:"averageWins"`
+
+	// Cards Card history, newest first
+	Cards []TrialsCard `json:"cards"`
+
+	// FailedLoadouts Loadouts used on failed cards, most used first
+	FailedLoadouts []TrialsCardLoadout `json:"failedLoadouts"`
+
+	// Flawless Cards that went flawless
+	Flawless int `json:"flawless"`
+
+	// FlawlessLoadouts Loadouts used on flawless cards, most used first
+	FlawlessLoadouts []TrialsCardLoadout `json:"flawlessLoadouts"`
+
+	// FlawlessRate Share of the finished cards that went flawless
+	FlawlessRate float64 `json:"flawlessRate"`
+
+	// Total Cards played, including the ones left out of the history
+	Total int `json:"total"`
+}
+
+// UniqueStatValue defines model for UniqueStatValue.
+type UniqueStatValue struct {
+	// ActivityID When a stat represents the best, most, longest, fastest or some other personal best, the actual activity ID where that personal best was established is available on this property.
+	ActivityID *int64 `firestore:"activityId" json:"activityId"`
+
+	// Basic Basic stat value.
+	Basic StatsValuePair `firestore:"basic" json:"basic"`
+	Name  *string        `firestore:"name" json:"name,omitempty"`
+
+	// Pga Per game average for the statistic, if applicable
+	Pga *StatsValuePair `firestore:"pga" json:"pga,omitempty"`
+
+	// Weighted Weighted value of the stat if a weight greater than 1 has been assigned.
+	Weighted *StatsValuePair `firestore:"weighted" json:"weighted,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	CharacterIDs        []string     `firestore:"characterIds" json:"characterIds"`
+	CreatedAt           time.Time    `firestore:"createdAt" json:"createdAt"`
+	DisplayName         string       `firestore:"displayName" json:"displayName"`
+	ID                  string       `firestore:"id" json:"id"`
+	MemberID            string       `firestore:"memberId" json:"memberId"`
+	Memberships         []Membership `json:"memberships"`
+	PrimaryMembershipID string       `firestore:"primaryMembershipId" json:"primaryMembershipId"`
+	UniqueName          string       `firestore:"uniqueName" json:"uniqueName"`
+}
+
+// WeaponBaseline The character's totals over every weapon, used as the baseline for weapon groups.
+type WeaponBaseline struct {
+	Kills          int     `json:"kills"`
+	Matches        int     `json:"matches"`
+	PrecisionKills int     `json:"precisionKills"`
+	PrecisionRate  float64 `json:"precisionRate"`
+	WinRate        float64 `json:"winRate"`
+}
+
+// WeaponGroupPerformance Totals of every weapon sharing an archetype, damage type or tier. Matches are counted per weapon, a match counts once for each weapon of the group that got a kill in it.
+type WeaponGroupPerformance struct {
+	Kills int `json:"kills"`
+
+	// KillsShare Share of the character's weapon kills made with the group
+	KillsShare     float64 `json:"killsShare"`
+	Name           string  `json:"name"`
+	PrecisionKills int     `json:"precisionKills"`
+	PrecisionRate  float64 `json:"precisionRate"`
+
+	// PrecisionRateDifference Precision rate minus the character's precision rate over every weapon
+	PrecisionRateDifference float64 `json:"precisionRateDifference"`
+
+	// WeaponMatches Sum of the matches of each weapon in the group, a match with two of its weapons counts twice. The rollups don't keep which matches a weapon was used in, so distinct matches aren't known.
+	WeaponMatches int `json:"weaponMatches"`
+
+	// WeaponWins Sum of the wins of each weapon in the group, counted like weaponMatches
+	WeaponWins int `json:"weaponWins"`
+
+	// Weapons Number of distinct weapons in the group
+	Weapons int `json:"weapons"`
+
+	// WinRate weaponWins over weaponMatches, weighting each weapon by its matches
+	WinRate float64 `json:"winRate"`
+
+	// WinRateDifference Win rate minus the character's win rate
+	WinRateDifference float64 `json:"winRateDifference"`
+}
+
+// WeaponInstanceMetrics defines model for WeaponInstanceMetrics.
+type WeaponInstanceMetrics struct {
+	Display *Display `firestore:"display" json:"display,omitempty"`
+
+	// ItemProperties The response object for retrieving an individual instanced item. None of these components are relevant for an item that doesn't have an "itemInstanceId": for those, get your information from the DestinyInventoryDefinition.
+	ItemProperties *ItemProperties `firestore:"itemProperties" json:"properties,omitempty"`
+
+	// ReferenceID The hash ID of the item definition that describes the weapon.
+	ReferenceID *int64                      `firestore:"referenceId" json:"referenceId,omitempty"`
+	Stats       *map[string]UniqueStatValue `firestore:"stats" json:"stats,omitempty"`
+}
+
+// WeaponPerformance A character's performance with a single weapon across the matches it recorded a kill in.
+type WeaponPerformance struct {
+	Display *Display `firestore:"display" json:"display,omitempty"`
+
+	// InstanceID Specific instance of the weapon. Only set when grouping by instance and the match was linked to a snapshot.
+	InstanceID    *string `json:"instanceId,omitempty"`
+	Kills         int     `json:"kills"`
+	KillsPerMatch float64 `json:"killsPerMatch"`
+
+	// Matches Number of matches the weapon was used in
+	Matches        int `json:"matches"`
+	PrecisionKills int `json:"precisionKills"`
+
+	// PrecisionRate Share of kills that were precision kills, between 0 and 1
+	PrecisionRate float64 `json:"precisionRate"`
+
+	// ReferenceID The hash ID of the item definition that describes the weapon.
+	ReferenceID int64 `json:"referenceId"`
+
+	// WinRate Share of matches won while the weapon was used, between 0 and 1
+	WinRate float64 `json:"winRate"`
+	Wins    int     `json:"wins"`
+}
+
+// WeaponTypeBreakdown A character's weapons grouped by archetype, damage type and tier, most kills first.
+type WeaponTypeBreakdown struct {
+	Archetypes []WeaponGroupPerformance `json:"archetypes"`
+
+	// Baseline The character's totals over every weapon, used as the baseline for weapon groups.
+	Baseline    WeaponBaseline           `json:"baseline"`
+	DamageTypes []WeaponGroupPerformance `json:"damageTypes"`
+	Tiers       []WeaponGroupPerformance `json:"tiers"`
+}
+
+// TiltThreshold defines model for TiltThreshold.
+type TiltThreshold = float64
+
+// TiltWindow defines model for TiltWindow.
+type TiltWindow = int
+
+// XMembershipID defines model for X-Membership-ID.
+type XMembershipID = string
+
+// XUserID defines model for X-User-ID.
 type XUserID = string
 
 // GetActivitiesParams defines parameters for GetActivities.
@@ -552,24 +1504,278 @@ type GetActivityParams struct {
 	CharacterID *string `form:"characterId,omitempty" json:"characterId,omitempty"`
 }
 
+// GenerateDigestsParams defines parameters for GenerateDigests.
+type GenerateDigestsParams struct {
+	UserID *string `form:"userId,omitempty" json:"userId,omitempty"`
+}
+
+// RebuildRollupsParams defines parameters for RebuildRollups.
+type RebuildRollupsParams struct {
+	CharacterID *string `form:"characterId,omitempty" json:"characterId,omitempty"`
+	MissingOnly *bool   `form:"missingOnly,omitempty" json:"missingOnly,omitempty"`
+}
+
 // GetFireteamParams defines parameters for GetFireteam.
 type GetFireteamParams struct {
 	XUserID       XUserID       `json:"X-User-ID"`
 	XMembershipID XMembershipID `json:"X-Membership-ID"`
 }
 
+// GetGroupsParams defines parameters for GetGroups.
+type GetGroupsParams struct {
+	Invited *bool   `form:"invited,omitempty" json:"invited,omitempty"`
+	XUserID XUserID `json:"X-User-ID"`
+}
+
+// CreateGroupJSONBody defines parameters for CreateGroup.
+type CreateGroupJSONBody struct {
+	// MemberIDs Ids of the users to invite, who join once they accept. The owner is always a member.
+	MemberIDs *[]string `json:"memberIds,omitempty"`
+	Name      string    `json:"name"`
+}
+
+// CreateGroupParams defines parameters for CreateGroup.
+type CreateGroupParams struct {
+	XUserID XUserID `json:"X-User-ID"`
+}
+
+// DeleteGroupParams defines parameters for DeleteGroup.
+type DeleteGroupParams struct {
+	XUserID XUserID `json:"X-User-ID"`
+}
+
+// UpdateGroupJSONBody defines parameters for UpdateGroup.
+type UpdateGroupJSONBody struct {
+	// MemberIDs Ids of the users in the group and the ones to invite, the owner is always kept
+	MemberIDs *[]string `json:"memberIds,omitempty"`
+	Name      *string   `json:"name,omitempty"`
+}
+
+// UpdateGroupParams defines parameters for UpdateGroup.
+type UpdateGroupParams struct {
+	XUserID XUserID `json:"X-User-ID"`
+}
+
+// RespondToGroupInvitationJSONBody defines parameters for RespondToGroupInvitation.
+type RespondToGroupInvitationJSONBody struct {
+	Accept bool `json:"accept"`
+}
+
+// RespondToGroupInvitationParams defines parameters for RespondToGroupInvitation.
+type RespondToGroupInvitationParams struct {
+	XUserID XUserID `json:"X-User-ID"`
+}
+
+// GetLeaderboardParams defines parameters for GetLeaderboard.
+type GetLeaderboardParams struct {
+	Scope LeaderboardScope `form:"scope" json:"scope"`
+
+	// GroupID Group to rank, required for the group scope
+	GroupID *string            `form:"groupId,omitempty" json:"groupId,omitempty"`
+	Metric  *LeaderboardMetric `form:"metric,omitempty" json:"metric,omitempty"`
+
+	// WeaponHash Weapon whose kills are ranked, required for the weaponKills metric
+	WeaponHash *int64 `form:"weaponHash,omitempty" json:"weaponHash,omitempty"`
+
+	// From Start of the window, defaults to a week before the end
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the window, defaults to now
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// MinimumMatches Users with fewer matches in the window are left off the leaderboard
+	MinimumMatches *int    `form:"minimumMatches,omitempty" json:"minimumMatches,omitempty"`
+	XUserID        XUserID `json:"X-User-ID"`
+}
+
 // LoginJSONBody defines parameters for Login.
 type LoginJSONBody struct {
 	Code string `json:"code"`
 }
 
+// GetAbilityKillsParams defines parameters for GetAbilityKills.
+type GetAbilityKillsParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+}
+
 // GetBestPerformingLoadoutsParams defines parameters for GetBestPerformingLoadouts.
 type GetBestPerformingLoadoutsParams struct {
-	CharacterID  string    `form:"characterId" json:"characterId"`
-	UserID       string    `form:"userId" json:"userId"`
-	GameMode     *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
-	Count        *int      `form:"count,omitempty" json:"count,omitempty"`
-	MinimumGames *int      `form:"minimumGames,omitempty" json:"minimumGames,omitempty"`
+	CharacterID string    `form:"characterId" json:"characterId"`
+	UserID      string    `form:"userId" json:"userId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter       *StatsFilter    `json:"filter,omitempty"`
+	Count        *int            `form:"count,omitempty" json:"count,omitempty"`
+	MinimumGames *int            `form:"minimumGames,omitempty" json:"minimumGames,omitempty"`
+	Ranking      *LoadoutRanking `form:"ranking,omitempty" json:"ranking,omitempty"`
+}
+
+// GetClassStatAnalysisParams defines parameters for GetClassStatAnalysis.
+type GetClassStatAnalysisParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// MinimumMatches Matches a tier needs before it can be recommended
+	MinimumMatches *int `form:"minimumMatches,omitempty" json:"minimumMatches,omitempty"`
+}
+
+// CompareLoadoutsParams defines parameters for CompareLoadouts.
+type CompareLoadoutsParams struct {
+	CharacterID string `form:"characterId" json:"characterId"`
+
+	// A Snapshot ID of the first loadout
+	A string `form:"a" json:"a"`
+
+	// B Snapshot ID of the second loadout
+	B        string    `form:"b" json:"b"`
+	GameMode *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+}
+
+// GetMapPerformanceParams defines parameters for GetMapPerformance.
+type GetMapPerformanceParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// SnapshotID Only include matches linked to this snapshot
+	SnapshotID *string `form:"snapshotId,omitempty" json:"snapshotId,omitempty"`
+}
+
+// GetMetaReportParams defines parameters for GetMetaReport.
+type GetMetaReportParams struct {
+	GameMode *GameMode   `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+	Window   *MetaWindow `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// GetMostUsedLoadoutsParams defines parameters for GetMostUsedLoadouts.
+type GetMostUsedLoadoutsParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// From Only include matches played at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only include matches played before this time
+	To    *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Count *int       `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetRollupsParams defines parameters for GetRollups.
+type GetRollupsParams struct {
+	CharacterID string `form:"characterId" json:"characterId"`
+}
+
+// GetScheduleParams defines parameters for GetSchedule.
+type GetScheduleParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// Timezone IANA timezone the match times are converted to, e.g. America/New_York. Defaults to UTC.
+	Timezone *string `form:"timezone,omitempty" json:"timezone,omitempty"`
+}
+
+// GetStreaksParams defines parameters for GetStreaks.
+type GetStreaksParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// TiltWindow Number of recent matches compared with the baseline for tilt detection
+	TiltWindow *TiltWindow `form:"tiltWindow,omitempty" json:"tiltWindow,omitempty"`
+
+	// TiltThreshold Fraction of the baseline K/D the recent matches have to stay above before the player counts as tilted
+	TiltThreshold *TiltThreshold `form:"tiltThreshold,omitempty" json:"tiltThreshold,omitempty"`
+}
+
+// GetTeammatesParams defines parameters for GetTeammates.
+type GetTeammatesParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// MinimumMatches Only include partners the character played at least this many matches with
+	MinimumMatches *int `form:"minimumMatches,omitempty" json:"minimumMatches,omitempty"`
+	Count          *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetTrendParams defines parameters for GetTrend.
+type GetTrendParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// SnapshotID Only include matches linked to this snapshot
+	SnapshotID *string      `form:"snapshotId,omitempty" json:"snapshotId,omitempty"`
+	Bucket     *TrendBucket `form:"bucket,omitempty" json:"bucket,omitempty"`
+
+	// Window Number of matches in each point when using the rolling bucket
+	Window *int `form:"window,omitempty" json:"window,omitempty"`
+}
+
+// GetTrialsCardsParams defines parameters for GetTrialsCards.
+type GetTrialsCardsParams struct {
+	CharacterID string `form:"characterId" json:"characterId"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// Count Number of cards to return in the history
+	Count *int `form:"count,omitempty" json:"count,omitempty"`
+}
+
+// GetWeaponTypePerformanceParams defines parameters for GetWeaponTypePerformance.
+type GetWeaponTypePerformanceParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+}
+
+// GetWeaponPerformanceParams defines parameters for GetWeaponPerformance.
+type GetWeaponPerformanceParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
+
+	// ByInstance Split results per weapon instance instead of per item hash
+	ByInstance *bool `form:"byInstance,omitempty" json:"byInstance,omitempty"`
+}
+
+// GetPerkPerformanceParams defines parameters for GetPerkPerformance.
+type GetPerkPerformanceParams struct {
+	CharacterID string    `form:"characterId" json:"characterId"`
+	GameMode    *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
 }
 
 // RefreshTokenJSONBody defines parameters for RefreshToken.
@@ -619,6 +1825,15 @@ type UpdateSessionParams struct {
 	XMembershipID XMembershipID `json:"X-Membership-ID"`
 }
 
+// GetSessionAggregatesParams defines parameters for GetSessionAggregates.
+type GetSessionAggregatesParams struct {
+	// TiltWindow Number of recent matches compared with the baseline for tilt detection
+	TiltWindow *TiltWindow `form:"tiltWindow,omitempty" json:"tiltWindow,omitempty"`
+
+	// TiltThreshold Fraction of the baseline K/D the recent matches have to stay above before the player counts as tilted
+	TiltThreshold *TiltThreshold `form:"tiltThreshold,omitempty" json:"tiltThreshold,omitempty"`
+}
+
 // CompleteSessionJSONBody defines parameters for CompleteSession.
 type CompleteSessionJSONBody struct {
 	CharacterID string  `json:"characterId"`
@@ -632,17 +1847,66 @@ type CompleteSessionParams struct {
 	XMembershipID XMembershipID `json:"X-Membership-ID"`
 }
 
+// GetShareLinksParams defines parameters for GetShareLinks.
+type GetShareLinksParams struct {
+	// ResourceID Only return links for this snapshot or session
+	ResourceID *string `form:"resourceId,omitempty" json:"resourceId,omitempty"`
+	XUserID    XUserID `json:"X-User-ID"`
+}
+
+// CreateShareLinkJSONBody defines parameters for CreateShareLink.
+type CreateShareLinkJSONBody struct {
+	// ExpiresAt Optional expiry for the link
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	ResourceID string     `json:"resourceId"`
+
+	// Type Kind of resource a share link points to
+	Type ShareType `firestore:"type" json:"type"`
+}
+
+// CreateShareLinkParams defines parameters for CreateShareLink.
+type CreateShareLinkParams struct {
+	XUserID XUserID `json:"X-User-ID"`
+}
+
+// RevokeShareLinkParams defines parameters for RevokeShareLink.
+type RevokeShareLinkParams struct {
+	XUserID XUserID `json:"X-User-ID"`
+}
+
 // GetSnapshotsParams defines parameters for GetSnapshots.
 type GetSnapshotsParams struct {
-	Count       int64   `form:"count" json:"count"`
-	Page        int64   `form:"page" json:"page"`
-	CharacterID string  `form:"characterId" json:"characterId"`
-	XUserID     XUserID `json:"X-User-ID"`
+	Count       int64  `form:"count" json:"count"`
+	Page        int64  `form:"page" json:"page"`
+	CharacterID string `form:"characterId" json:"characterId"`
+
+	// Search Case-insensitive match against the snapshot name, description or item names
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Tag Only return snapshots with this tag
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// Favorite Only return snapshots with a matching favorite flag
+	Favorite *bool `form:"favorite,omitempty" json:"favorite,omitempty"`
+
+	// Archived Only return archived snapshots when true. Archived snapshots are excluded by default.
+	Archived *bool `form:"archived,omitempty" json:"archived,omitempty"`
+
+	// WeaponHash Only return snapshots containing the item with this hash
+	WeaponHash *int64 `form:"weaponHash,omitempty" json:"weaponHash,omitempty"`
+
+	// DamageType Only return snapshots containing a weapon of this damage type, e.g. Solar
+	DamageType *string       `form:"damageType,omitempty" json:"damageType,omitempty"`
+	Sort       *SnapshotSort `form:"sort,omitempty" json:"sort,omitempty"`
+	XUserID    XUserID       `json:"X-User-ID"`
 }
 
 // CreateSnapshotJSONBody defines parameters for CreateSnapshot.
 type CreateSnapshotJSONBody struct {
 	CharacterID string `json:"characterId"`
+
+	// NameStyle How the system names new snapshots. meme picks a random PvP flavoured name, descriptive builds one from the subclass and weapons, e.g. "Solar Hunter: Ace of Spades + Fusion".
+	NameStyle *SnapshotNameStyle `json:"nameStyle,omitempty"`
 }
 
 // CreateSnapshotParams defines parameters for CreateSnapshot.
@@ -653,11 +1917,20 @@ type CreateSnapshotParams struct {
 
 // UpdateSnapshotJSONBody defines parameters for UpdateSnapshot.
 type UpdateSnapshotJSONBody struct {
+	// Archived Archive or restore the snapshot
+	Archived *bool `json:"archived,omitempty"`
+
 	// Description Description of the snapshot
 	Description *string `json:"description,omitempty"`
 
+	// Favorite Mark or unmark the snapshot as a favorite
+	Favorite *bool `json:"favorite,omitempty"`
+
 	// Name Name of the snapshot
 	Name string `json:"name"`
+
+	// Tags Replaces the tags on the snapshot
+	Tags *[]string `json:"tags,omitempty"`
 }
 
 // UpdateSnapshotParams defines parameters for UpdateSnapshot.
@@ -669,6 +1942,9 @@ type UpdateSnapshotParams struct {
 type GetSnapshotAggregatesParams struct {
 	// GameMode The game mode for the snapshot metrics
 	GameMode *GameMode `form:"gameMode,omitempty" json:"gameMode,omitempty"`
+
+	// Filter Narrows down the matches counted. Applied on top of the game mode and the other filters of the endpoint.
+	Filter *StatsFilter `json:"filter,omitempty"`
 }
 
 // MergeSnapshotsJSONBody defines parameters for MergeSnapshots.
@@ -681,6 +1957,12 @@ type MergeSnapshotsParams struct {
 	XUserID XUserID `json:"X-User-ID"`
 }
 
+// GetUserDigestsParams defines parameters for GetUserDigests.
+type GetUserDigestsParams struct {
+	Count   *int    `form:"count,omitempty" json:"count,omitempty"`
+	XUserID XUserID `json:"X-User-ID"`
+}
+
 // GetUserSessionsParams defines parameters for GetUserSessions.
 type GetUserSessionsParams struct {
 	Count       int64                        `form:"count" json:"count"`
@@ -704,6 +1986,15 @@ type StartUserSessionParams struct {
 	XMembershipID XMembershipID `json:"X-Membership-ID"`
 }
 
+// CreateGroupJSONRequestBody defines body for CreateGroup for application/json ContentType.
+type CreateGroupJSONRequestBody CreateGroupJSONBody
+
+// UpdateGroupJSONRequestBody defines body for UpdateGroup for application/json ContentType.
+type UpdateGroupJSONRequestBody UpdateGroupJSONBody
+
+// RespondToGroupInvitationJSONRequestBody defines body for RespondToGroupInvitation for application/json ContentType.
+type RespondToGroupInvitationJSONRequestBody RespondToGroupInvitationJSONBody
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody LoginJSONBody
 
@@ -722,6 +2013,9 @@ type UpdateSessionJSONRequestBody UpdateSessionJSONBody
 // CompleteSessionJSONRequestBody defines body for CompleteSession for application/json ContentType.
 type CompleteSessionJSONRequestBody CompleteSessionJSONBody
 
+// CreateShareLinkJSONRequestBody defines body for CreateShareLink for application/json ContentType.
+type CreateShareLinkJSONRequestBody CreateShareLinkJSONBody
+
 // CreateSnapshotJSONRequestBody defines body for CreateSnapshot for application/json ContentType.
 type CreateSnapshotJSONRequestBody CreateSnapshotJSONBody
 
@@ -749,21 +2043,99 @@ type ServerInterface interface {
 	// (POST /admin/backfill-character-ids)
 	BackfillAllUsersCharacterIds(c *gin.Context)
 
+	// (POST /admin/backfill-lobby-strength)
+	BackfillLobbyStrength(c *gin.Context)
+
 	// (POST /admin/backfill-snapshot-base-info)
 	BackfillSnapshotInfo(c *gin.Context)
 
+	// (POST /admin/generate-digests)
+	GenerateDigests(c *gin.Context, params GenerateDigestsParams)
+
+	// (POST /admin/generate-meta-reports)
+	GenerateMetaReports(c *gin.Context)
+
+	// (POST /admin/rebuild-rollups)
+	RebuildRollups(c *gin.Context, params RebuildRollupsParams)
+
 	// (GET /fireteam)
 	GetFireteam(c *gin.Context, params GetFireteamParams)
 
+	// (GET /groups)
+	GetGroups(c *gin.Context, params GetGroupsParams)
+
+	// (POST /groups)
+	CreateGroup(c *gin.Context, params CreateGroupParams)
+
+	// (DELETE /groups/{groupId})
+	DeleteGroup(c *gin.Context, groupID string, params DeleteGroupParams)
+
+	// (PUT /groups/{groupId})
+	UpdateGroup(c *gin.Context, groupID string, params UpdateGroupParams)
+
+	// (POST /groups/{groupId}/invitation)
+	RespondToGroupInvitation(c *gin.Context, groupID string, params RespondToGroupInvitationParams)
+	// Ranks the user's friends, fireteam or group over a time window
+	// (GET /leaderboards)
+	GetLeaderboard(c *gin.Context, params GetLeaderboardParams)
+
 	// (POST /login)
 	Login(c *gin.Context)
+	// Ability kill share for a character and each of its snapshots
+	// (GET /metrics/abilities)
+	GetAbilityKills(c *gin.Context, params GetAbilityKillsParams)
 
 	// (GET /metrics/best-performing-loadouts)
 	GetBestPerformingLoadouts(c *gin.Context, params GetBestPerformingLoadoutsParams)
+	// Correlates the class stats of a character's snapshots with performance
+	// (GET /metrics/class-stats)
+	GetClassStatAnalysis(c *gin.Context, params GetClassStatAnalysisParams)
+	// Compare two loadouts head to head
+	// (GET /metrics/compare)
+	CompareLoadouts(c *gin.Context, params CompareLoadoutsParams)
+
+	// (GET /metrics/maps)
+	GetMapPerformance(c *gin.Context, params GetMapPerformanceParams)
+	// Community meta across every OneTrick player
+	// (GET /metrics/meta)
+	GetMetaReport(c *gin.Context, params GetMetaReportParams)
+
+	// (GET /metrics/most-used-loadouts)
+	GetMostUsedLoadouts(c *gin.Context, params GetMostUsedLoadoutsParams)
+
+	// (GET /metrics/rollups)
+	GetRollups(c *gin.Context, params GetRollupsParams)
+	// Performance by time of day and day of the week
+	// (GET /metrics/schedule)
+	GetSchedule(c *gin.Context, params GetScheduleParams)
+	// Win and loss streaks and tilt status for a character
+	// (GET /metrics/streaks)
+	GetStreaks(c *gin.Context, params GetStreaksParams)
+
+	// (GET /metrics/teammates)
+	GetTeammates(c *gin.Context, params GetTeammatesParams)
+	// Performance over time for a character
+	// (GET /metrics/trend)
+	GetTrend(c *gin.Context, params GetTrendParams)
+	// Trials of Osiris cards for a character
+	// (GET /metrics/trials-cards)
+	GetTrialsCards(c *gin.Context, params GetTrialsCardsParams)
+	// Weapon performance grouped by archetype, damage type and tier
+	// (GET /metrics/weapon-types)
+	GetWeaponTypePerformance(c *gin.Context, params GetWeaponTypePerformanceParams)
+
+	// (GET /metrics/weapons)
+	GetWeaponPerformance(c *gin.Context, params GetWeaponPerformanceParams)
+	// Compare the rolls of a weapon
+	// (GET /metrics/weapons/{weaponHash}/perks)
+	GetPerkPerformance(c *gin.Context, weaponHash int64, params GetPerkPerformanceParams)
 
 	// (GET /ping)
 	GetPing(c *gin.Context)
 
+	// (GET /public/shares/{token})
+	GetSharedContent(c *gin.Context, token string)
+
 	// (POST /refresh)
 	RefreshToken(c *gin.Context)
 
@@ -783,11 +2155,20 @@ type ServerInterface interface {
 	UpdateSession(c *gin.Context, sessionID string, params UpdateSessionParams)
 
 	// (GET /sessions/{sessionId}/aggregates)
-	GetSessionAggregates(c *gin.Context, sessionId string)
+	GetSessionAggregates(c *gin.Context, sessionId string, params GetSessionAggregatesParams)
 
 	// (PUT /sessions/{sessionId}/complete)
 	CompleteSession(c *gin.Context, sessionId string, params CompleteSessionParams)
 
+	// (GET /shares)
+	GetShareLinks(c *gin.Context, params GetShareLinksParams)
+
+	// (POST /shares)
+	CreateShareLink(c *gin.Context, params CreateShareLinkParams)
+
+	// (DELETE /shares/{token})
+	RevokeShareLink(c *gin.Context, token string, params RevokeShareLinkParams)
+
 	// (GET /snapshots)
 	GetSnapshots(c *gin.Context, params GetSnapshotsParams)
 
@@ -808,6 +2189,9 @@ type ServerInterface interface {
 
 	// (GET /users/{userId})
 	GetUser(c *gin.Context, userID string)
+	// Weekly digests of a user
+	// (GET /users/{userId}/digests)
+	GetUserDigests(c *gin.Context, userID string, params GetUserDigestsParams)
 
 	// (GET /users/{userId}/sessions)
 	GetUserSessions(c *gin.Context, userID string, params GetUserSessionsParams)
@@ -1003,6 +2387,19 @@ func (siw *ServerInterfaceWrapper) BackfillAllUsersCharacterIds(c *gin.Context)
 	siw.Handler.BackfillAllUsersCharacterIds(c)
 }
 
+// BackfillLobbyStrength operation middleware
+func (siw *ServerInterfaceWrapper) BackfillLobbyStrength(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BackfillLobbyStrength(c)
+}
+
 // BackfillSnapshotInfo operation middleware
 func (siw *ServerInterfaceWrapper) BackfillSnapshotInfo(c *gin.Context) {
 
@@ -1016,173 +2413,22 @@ func (siw *ServerInterfaceWrapper) BackfillSnapshotInfo(c *gin.Context) {
 	siw.Handler.BackfillSnapshotInfo(c)
 }
 
-// GetFireteam operation middleware
-func (siw *ServerInterfaceWrapper) GetFireteam(c *gin.Context) {
+// GenerateDigests operation middleware
+func (siw *ServerInterfaceWrapper) GenerateDigests(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetFireteamParams
+	var params GenerateDigestsParams
 
-	headers := c.Request.Header
+	// ------------- Optional query parameter "userId" -------------
 
-	// ------------- Required header parameter "X-User-ID" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
-		var XUserID XUserID
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
-			return
-		}
-
-		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
-			return
-		}
-
-		params.XUserID = XUserID
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Required header parameter "X-Membership-ID" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
-		var XMembershipID XMembershipID
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
-			return
-		}
-
-		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
-			return
-		}
-
-		params.XMembershipID = XMembershipID
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetFireteam(c, params)
-}
-
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.Login(c)
-}
-
-// GetBestPerformingLoadouts operation middleware
-func (siw *ServerInterfaceWrapper) GetBestPerformingLoadouts(c *gin.Context) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBestPerformingLoadoutsParams
-
-	// ------------- Required query parameter "characterId" -------------
-
-	if paramValue := c.Query("characterId"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Required query parameter "userId" -------------
-
-	if paramValue := c.Query("userId"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument userId is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "userId", c.Request.URL.Query(), &params.UserID)
+	err = runtime.BindQueryParameter("form", true, false, "userId", c.Request.URL.Query(), &params.UserID)
 	if err != nil {
 		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "gameMode" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "count" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "minimumGames" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "minimumGames", c.Request.URL.Query(), &params.MinimumGames)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minimumGames: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetBestPerformingLoadouts(c, params)
-}
-
-// GetPing operation middleware
-func (siw *ServerInterfaceWrapper) GetPing(c *gin.Context) {
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.GetPing(c)
-}
-
-// RefreshToken operation middleware
-func (siw *ServerInterfaceWrapper) RefreshToken(c *gin.Context) {
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1190,11 +2436,11 @@ func (siw *ServerInterfaceWrapper) RefreshToken(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RefreshToken(c)
+	siw.Handler.GenerateDigests(c, params)
 }
 
-// Search operation middleware
-func (siw *ServerInterfaceWrapper) Search(c *gin.Context) {
+// GenerateMetaReports operation middleware
+func (siw *ServerInterfaceWrapper) GenerateMetaReports(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1203,46 +2449,16 @@ func (siw *ServerInterfaceWrapper) Search(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Search(c)
+	siw.Handler.GenerateMetaReports(c)
 }
 
-// GetSessions operation middleware
-func (siw *ServerInterfaceWrapper) GetSessions(c *gin.Context) {
+// RebuildRollups operation middleware
+func (siw *ServerInterfaceWrapper) RebuildRollups(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSessionsParams
-
-	// ------------- Required query parameter "count" -------------
-
-	if paramValue := c.Query("count"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument count is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "count", c.Request.URL.Query(), &params.Count)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Required query parameter "page" -------------
-
-	if paramValue := c.Query("page"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument page is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "page", c.Request.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
+	var params RebuildRollupsParams
 
 	// ------------- Optional query parameter "characterId" -------------
 
@@ -1252,19 +2468,11 @@ func (siw *ServerInterfaceWrapper) GetSessions(c *gin.Context) {
 		return
 	}
 
-	// ------------- Optional query parameter "userId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "userId", c.Request.URL.Query(), &params.UserID)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "missingOnly" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "missingOnly", c.Request.URL.Query(), &params.MissingOnly)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter missingOnly: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1275,16 +2483,18 @@ func (siw *ServerInterfaceWrapper) GetSessions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSessions(c, params)
+	siw.Handler.RebuildRollups(c, params)
 }
 
-// StartSession operation middleware
-func (siw *ServerInterfaceWrapper) StartSession(c *gin.Context) {
+// GetFireteam operation middleware
+func (siw *ServerInterfaceWrapper) GetFireteam(c *gin.Context) {
 
 	var err error
 
+	c.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params StartSessionParams
+	var params GetFireteamParams
 
 	headers := c.Request.Header
 
@@ -1339,20 +2549,46 @@ func (siw *ServerInterfaceWrapper) StartSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StartSession(c, params)
+	siw.Handler.GetFireteam(c, params)
 }
 
-// GetSession operation middleware
-func (siw *ServerInterfaceWrapper) GetSession(c *gin.Context) {
+// GetGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetGroups(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sessionId" -------------
-	var sessionId string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGroupsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "invited" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "invited", c.Request.URL.Query(), &params.Invited)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter invited: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
@@ -1363,25 +2599,16 @@ func (siw *ServerInterfaceWrapper) GetSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSession(c, sessionId)
+	siw.Handler.GetGroups(c, params)
 }
 
-// UpdateSession operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSession(c *gin.Context) {
+// CreateGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroup(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sessionId" -------------
-	var sessionID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateSessionParams
+	var params CreateGroupParams
 
 	headers := c.Request.Header
 
@@ -1407,52 +2634,6 @@ func (siw *ServerInterfaceWrapper) UpdateSession(c *gin.Context) {
 		return
 	}
 
-	// ------------- Required header parameter "X-Membership-ID" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
-		var XMembershipID XMembershipID
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
-			return
-		}
-
-		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
-			return
-		}
-
-		params.XMembershipID = XMembershipID
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
-	}
-
-	siw.Handler.UpdateSession(c, sessionID, params)
-}
-
-// GetSessionAggregates operation middleware
-func (siw *ServerInterfaceWrapper) GetSessionAggregates(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "sessionId" -------------
-	var sessionId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1460,25 +2641,25 @@ func (siw *ServerInterfaceWrapper) GetSessionAggregates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSessionAggregates(c, sessionId)
+	siw.Handler.CreateGroup(c, params)
 }
 
-// CompleteSession operation middleware
-func (siw *ServerInterfaceWrapper) CompleteSession(c *gin.Context) {
+// DeleteGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGroup(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "sessionId" -------------
-	var sessionId string
+	// ------------- Path parameter "groupId" -------------
+	var groupID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
 		return
 	}
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CompleteSessionParams
+	var params DeleteGroupParams
 
 	headers := c.Request.Header
 
@@ -1504,28 +2685,6 @@ func (siw *ServerInterfaceWrapper) CompleteSession(c *gin.Context) {
 		return
 	}
 
-	// ------------- Required header parameter "X-Membership-ID" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
-		var XMembershipID XMembershipID
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
-			return
-		}
-
-		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
-			return
-		}
-
-		params.XMembershipID = XMembershipID
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1533,63 +2692,25 @@ func (siw *ServerInterfaceWrapper) CompleteSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CompleteSession(c, sessionId, params)
+	siw.Handler.DeleteGroup(c, groupID, params)
 }
 
-// GetSnapshots operation middleware
-func (siw *ServerInterfaceWrapper) GetSnapshots(c *gin.Context) {
+// UpdateGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateGroup(c *gin.Context) {
 
 	var err error
 
-	c.Set(BearerAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSnapshotsParams
-
-	// ------------- Required query parameter "count" -------------
-
-	if paramValue := c.Query("count"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument count is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "count", c.Request.URL.Query(), &params.Count)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Required query parameter "page" -------------
-
-	if paramValue := c.Query("page"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument page is required, but not found"), http.StatusBadRequest)
-		return
-	}
+	// ------------- Path parameter "groupId" -------------
+	var groupID string
 
-	err = runtime.BindQueryParameter("form", true, true, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Required query parameter "characterId" -------------
-
-	if paramValue := c.Query("characterId"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
-		return
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateGroupParams
 
 	headers := c.Request.Header
 
@@ -1622,16 +2743,25 @@ func (siw *ServerInterfaceWrapper) GetSnapshots(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSnapshots(c, params)
+	siw.Handler.UpdateGroup(c, groupID, params)
 }
 
-// CreateSnapshot operation middleware
-func (siw *ServerInterfaceWrapper) CreateSnapshot(c *gin.Context) {
+// RespondToGroupInvitation operation middleware
+func (siw *ServerInterfaceWrapper) RespondToGroupInvitation(c *gin.Context) {
 
 	var err error
 
+	// ------------- Path parameter "groupId" -------------
+	var groupID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", c.Param("groupId"), &groupID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateSnapshotParams
+	var params RespondToGroupInvitationParams
 
 	headers := c.Request.Header
 
@@ -1657,28 +2787,6 @@ func (siw *ServerInterfaceWrapper) CreateSnapshot(c *gin.Context) {
 		return
 	}
 
-	// ------------- Required header parameter "X-Membership-ID" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
-		var XMembershipID XMembershipID
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
-			return
-		}
-
-		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
-			return
-		}
-
-		params.XMembershipID = XMembershipID
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1686,49 +2794,79 @@ func (siw *ServerInterfaceWrapper) CreateSnapshot(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateSnapshot(c, params)
+	siw.Handler.RespondToGroupInvitation(c, groupID, params)
 }
 
-// GetSnapshot operation middleware
-func (siw *ServerInterfaceWrapper) GetSnapshot(c *gin.Context) {
+// GetLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetLeaderboard(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "snapshotId" -------------
-	var snapshotID string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeaderboardParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Param("snapshotId"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Required query parameter "scope" -------------
+
+	if paramValue := c.Query("scope"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument scope is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "scope", c.Request.URL.Query(), &params.Scope)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter scope: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// ------------- Optional query parameter "groupId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupId", c.Request.URL.Query(), &params.GroupID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter groupId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.GetSnapshot(c, snapshotID)
-}
+	// ------------- Optional query parameter "metric" -------------
 
-// UpdateSnapshot operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSnapshot(c *gin.Context) {
+	err = runtime.BindQueryParameter("form", true, false, "metric", c.Request.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter metric: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	var err error
+	// ------------- Optional query parameter "weaponHash" -------------
 
-	// ------------- Path parameter "snapshotId" -------------
-	var snapshotID string
+	err = runtime.BindQueryParameter("form", true, false, "weaponHash", c.Request.URL.Query(), &params.WeaponHash)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter weaponHash: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Param("snapshotId"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params UpdateSnapshotParams
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minimumMatches" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minimumMatches", c.Request.URL.Query(), &params.MinimumMatches)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minimumMatches: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	headers := c.Request.Header
 
@@ -1761,25 +2899,44 @@ func (siw *ServerInterfaceWrapper) UpdateSnapshot(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateSnapshot(c, snapshotID, params)
+	siw.Handler.GetLeaderboard(c, params)
 }
 
-// GetSnapshotAggregates operation middleware
-func (siw *ServerInterfaceWrapper) GetSnapshotAggregates(c *gin.Context) {
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.Login(c)
+}
+
+// GetAbilityKills operation middleware
+func (siw *ServerInterfaceWrapper) GetAbilityKills(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "snapshotId" -------------
-	var snapshotID string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAbilityKillsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Param("snapshotId"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+	// ------------- Required query parameter "characterId" -------------
+
+	if paramValue := c.Query("characterId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSnapshotAggregatesParams
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	// ------------- Optional query parameter "gameMode" -------------
 
@@ -1789,6 +2946,14 @@ func (siw *ServerInterfaceWrapper) GetSnapshotAggregates(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1796,47 +2961,84 @@ func (siw *ServerInterfaceWrapper) GetSnapshotAggregates(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetSnapshotAggregates(c, snapshotID, params)
+	siw.Handler.GetAbilityKills(c, params)
 }
 
-// MergeSnapshots operation middleware
-func (siw *ServerInterfaceWrapper) MergeSnapshots(c *gin.Context) {
+// GetBestPerformingLoadouts operation middleware
+func (siw *ServerInterfaceWrapper) GetBestPerformingLoadouts(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "snapshotId" -------------
-	var snapshotID string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBestPerformingLoadoutsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Param("snapshotId"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+	// ------------- Required query parameter "characterId" -------------
+
+	if paramValue := c.Query("characterId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params MergeSnapshotsParams
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	headers := c.Request.Header
+	// ------------- Required query parameter "userId" -------------
 
-	// ------------- Required header parameter "X-User-ID" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
-		var XUserID XUserID
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
-			return
-		}
+	if paramValue := c.Query("userId"); paramValue != "" {
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
-			return
-		}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument userId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-		params.XUserID = XUserID
+	err = runtime.BindQueryParameter("form", true, true, "userId", c.Request.URL.Query(), &params.UserID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+	// ------------- Optional query parameter "gameMode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minimumGames" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minimumGames", c.Request.URL.Query(), &params.MinimumGames)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minimumGames: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "ranking" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ranking", c.Request.URL.Query(), &params.Ranking)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter ranking: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1847,20 +3049,53 @@ func (siw *ServerInterfaceWrapper) MergeSnapshots(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MergeSnapshots(c, snapshotID, params)
+	siw.Handler.GetBestPerformingLoadouts(c, params)
 }
 
-// GetUser operation middleware
-func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
+// GetClassStatAnalysis operation middleware
+func (siw *ServerInterfaceWrapper) GetClassStatAnalysis(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userID string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetClassStatAnalysisParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Required query parameter "characterId" -------------
+
+	if paramValue := c.Query("characterId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "gameMode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "minimumMatches" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "minimumMatches", c.Request.URL.Query(), &params.MinimumMatches)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minimumMatches: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1871,76 +3106,75 @@ func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUser(c, userID)
+	siw.Handler.GetClassStatAnalysis(c, params)
 }
 
-// GetUserSessions operation middleware
-func (siw *ServerInterfaceWrapper) GetUserSessions(c *gin.Context) {
+// CompareLoadouts operation middleware
+func (siw *ServerInterfaceWrapper) CompareLoadouts(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userID string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserSessionsParams
+	var params CompareLoadoutsParams
 
-	// ------------- Required query parameter "count" -------------
+	// ------------- Required query parameter "characterId" -------------
 
-	if paramValue := c.Query("count"); paramValue != "" {
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument count is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "count", c.Request.URL.Query(), &params.Count)
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Required query parameter "page" -------------
+	// ------------- Required query parameter "a" -------------
 
-	if paramValue := c.Query("page"); paramValue != "" {
+	if paramValue := c.Query("a"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument page is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Query argument a is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, true, "a", c.Request.URL.Query(), &params.A)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter a: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Required query parameter "characterId" -------------
+	// ------------- Required query parameter "b" -------------
 
-	if paramValue := c.Query("characterId"); paramValue != "" {
+	if paramValue := c.Query("b"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Query argument b is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	err = runtime.BindQueryParameter("form", true, true, "b", c.Request.URL.Query(), &params.B)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter b: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "gameMode" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1951,69 +3185,87 @@ func (siw *ServerInterfaceWrapper) GetUserSessions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUserSessions(c, userID, params)
+	siw.Handler.CompareLoadouts(c, params)
 }
 
-// StartUserSession operation middleware
-func (siw *ServerInterfaceWrapper) StartUserSession(c *gin.Context) {
+// GetMapPerformance operation middleware
+func (siw *ServerInterfaceWrapper) GetMapPerformance(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "userId" -------------
-	var userID string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMapPerformanceParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Required query parameter "characterId" -------------
+
+	if paramValue := c.Query("characterId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params StartUserSessionParams
+	// ------------- Optional query parameter "gameMode" -------------
 
-	headers := c.Request.Header
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	// ------------- Required header parameter "X-User-ID" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
-		var XUserID XUserID
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
-			return
-		}
+	// ------------- Optional query parameter "filter" -------------
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
-			return
-		}
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-		params.XUserID = XUserID
+	// ------------- Optional query parameter "snapshotId" -------------
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+	err = runtime.BindQueryParameter("form", true, false, "snapshotId", c.Request.URL.Query(), &params.SnapshotID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Required header parameter "X-Membership-ID" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
-		var XMembershipID XMembershipID
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
 			return
 		}
+	}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
-			return
-		}
+	siw.Handler.GetMapPerformance(c, params)
+}
 
-		params.XMembershipID = XMembershipID
+// GetMetaReport operation middleware
+func (siw *ServerInterfaceWrapper) GetMetaReport(c *gin.Context) {
 
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMetaReportParams
+
+	// ------------- Optional query parameter "gameMode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "window" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "window", c.Request.URL.Query(), &params.Window)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter window: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -2024,633 +3276,3445 @@ func (siw *ServerInterfaceWrapper) StartUserSession(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.StartUserSession(c, userID, params)
+	siw.Handler.GetMetaReport(c, params)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// GetMostUsedLoadouts operation middleware
+func (siw *ServerInterfaceWrapper) GetMostUsedLoadouts(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+	var err error
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
-	}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMostUsedLoadoutsParams
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
-	}
-
-	router.GET(options.BaseURL+"/activities", wrapper.GetActivities)
-	router.GET(options.BaseURL+"/activities/:activityId", wrapper.GetActivity)
-	router.POST(options.BaseURL+"/admin/backfill-aggregate-data", wrapper.BackfillAggregateData)
-	router.POST(options.BaseURL+"/admin/backfill-character-ids", wrapper.BackfillAllUsersCharacterIds)
-	router.POST(options.BaseURL+"/admin/backfill-snapshot-base-info", wrapper.BackfillSnapshotInfo)
-	router.GET(options.BaseURL+"/fireteam", wrapper.GetFireteam)
-	router.POST(options.BaseURL+"/login", wrapper.Login)
-	router.GET(options.BaseURL+"/metrics/best-performing-loadouts", wrapper.GetBestPerformingLoadouts)
-	router.GET(options.BaseURL+"/ping", wrapper.GetPing)
-	router.POST(options.BaseURL+"/refresh", wrapper.RefreshToken)
-	router.POST(options.BaseURL+"/search", wrapper.Search)
-	router.GET(options.BaseURL+"/sessions", wrapper.GetSessions)
-	router.POST(options.BaseURL+"/sessions", wrapper.StartSession)
-	router.GET(options.BaseURL+"/sessions/:sessionId", wrapper.GetSession)
-	router.PUT(options.BaseURL+"/sessions/:sessionId", wrapper.UpdateSession)
-	router.GET(options.BaseURL+"/sessions/:sessionId/aggregates", wrapper.GetSessionAggregates)
-	router.PUT(options.BaseURL+"/sessions/:sessionId/complete", wrapper.CompleteSession)
-	router.GET(options.BaseURL+"/snapshots", wrapper.GetSnapshots)
-	router.POST(options.BaseURL+"/snapshots", wrapper.CreateSnapshot)
-	router.GET(options.BaseURL+"/snapshots/:snapshotId", wrapper.GetSnapshot)
-	router.PUT(options.BaseURL+"/snapshots/:snapshotId", wrapper.UpdateSnapshot)
-	router.GET(options.BaseURL+"/snapshots/:snapshotId/aggregates", wrapper.GetSnapshotAggregates)
-	router.POST(options.BaseURL+"/snapshots/:snapshotId/merge", wrapper.MergeSnapshots)
-	router.GET(options.BaseURL+"/users/:userId", wrapper.GetUser)
-	router.GET(options.BaseURL+"/users/:userId/sessions", wrapper.GetUserSessions)
-	router.POST(options.BaseURL+"/users/:userId/sessions", wrapper.StartUserSession)
-}
-
-type GetActivitiesRequestObject struct {
-	Params GetActivitiesParams
-}
+	// ------------- Required query parameter "characterId" -------------
 
-type GetActivitiesResponseObject interface {
-	VisitGetActivitiesResponse(w http.ResponseWriter) error
-}
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-type GetActivities200JSONResponse []DetailActivity
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-func (response GetActivities200JSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "gameMode" -------------
 
-type GetActivityRequestObject struct {
-	ActivityID string `json:"activityId"`
-	Params     GetActivityParams
-}
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetActivityResponseObject interface {
-	VisitGetActivityResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "filter" -------------
 
-type GetActivity200JSONResponse struct {
-	Activity        ActivityHistory              `firestore:"activityHistory" json:"activity"`
-	Aggregate       *Aggregate                   `json:"aggregate,omitempty"`
-	PostGameEntries *[]map[string]interface{}    `json:"postGameEntries,omitempty"`
-	Snapshots       map[string]CharacterSnapshot `json:"snapshots"`
-	Teams           []Team                       `json:"teams"`
-	Users           map[string]User              `json:"users"`
-}
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetActivity200JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "from" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetActivity400JSONResponse OneTrickError
+	// ------------- Optional query parameter "to" -------------
 
-func (response GetActivity400JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "count" -------------
 
-type GetActivity404JSONResponse OneTrickError
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetActivity404JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetMostUsedLoadouts(c, params)
 }
 
-type GetActivity500JSONResponse OneTrickError
+// GetRollups operation middleware
+func (siw *ServerInterfaceWrapper) GetRollups(c *gin.Context) {
 
-func (response GetActivity500JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRollupsParams
 
-type BackfillAggregateDataRequestObject struct {
-}
+	// ------------- Required query parameter "characterId" -------------
 
-type BackfillAggregateDataResponseObject interface {
-	VisitBackfillAggregateDataResponse(w http.ResponseWriter) error
-}
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-type BackfillAggregateData200JSONResponse struct {
-	Failed  int32 `json:"failed"`
-	Updated int32 `json:"updated"`
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-func (response BackfillAggregateData200JSONResponse) VisitBackfillAggregateDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type BackfillAllUsersCharacterIdsRequestObject struct {
+	siw.Handler.GetRollups(c, params)
 }
 
-type BackfillAllUsersCharacterIdsResponseObject interface {
-	VisitBackfillAllUsersCharacterIdsResponse(w http.ResponseWriter) error
-}
+// GetSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetSchedule(c *gin.Context) {
 
-type BackfillAllUsersCharacterIds200JSONResponse struct {
-	Failed  int32 `json:"failed"`
-	Updated int32 `json:"updated"`
-}
+	var err error
 
-func (response BackfillAllUsersCharacterIds200JSONResponse) VisitBackfillAllUsersCharacterIdsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScheduleParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "characterId" -------------
 
-type BackfillSnapshotInfoRequestObject struct {
-}
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-type BackfillSnapshotInfoResponseObject interface {
-	VisitBackfillSnapshotInfoResponse(w http.ResponseWriter) error
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type BackfillSnapshotInfo200JSONResponse struct {
-	Failed  int32 `json:"failed"`
-	Updated int32 `json:"updated"`
-}
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response BackfillSnapshotInfo200JSONResponse) VisitBackfillSnapshotInfoResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "gameMode" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetFireteamRequestObject struct {
-	Params GetFireteamParams
-}
+	// ------------- Optional query parameter "filter" -------------
 
-type GetFireteamResponseObject interface {
-	VisitGetFireteamResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetFireteam200JSONResponse []FireteamMember
+	// ------------- Optional query parameter "timezone" -------------
 
-func (response GetFireteam200JSONResponse) VisitGetFireteamResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "timezone", c.Request.URL.Query(), &params.Timezone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter timezone: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetFireteam503JSONResponse struct {
-	// Message User friendly description of the error
-	Message string        `json:"message"`
-	Status  InternalError `json:"status"`
+	siw.Handler.GetSchedule(c, params)
 }
 
-func (response GetFireteam503JSONResponse) VisitGetFireteamResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+// GetStreaks operation middleware
+func (siw *ServerInterfaceWrapper) GetStreaks(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStreaksParams
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
-}
+	// ------------- Required query parameter "characterId" -------------
 
-type Login200JSONResponse AuthResponse
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetBestPerformingLoadoutsRequestObject struct {
-	Params GetBestPerformingLoadoutsParams
-}
+	// ------------- Optional query parameter "gameMode" -------------
 
-type GetBestPerformingLoadoutsResponseObject interface {
-	VisitGetBestPerformingLoadoutsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetBestPerformingLoadouts200JSONResponse struct {
-	Count map[string]int         `json:"count"`
-	Items []CharacterSnapshot    `json:"items"`
-	Stats map[string]PlayerStats `json:"stats"`
-}
+	// ------------- Optional query parameter "filter" -------------
 
-func (response GetBestPerformingLoadouts200JSONResponse) VisitGetBestPerformingLoadoutsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "tiltWindow" -------------
 
-type GetPingRequestObject struct {
-}
+	err = runtime.BindQueryParameter("form", true, false, "tiltWindow", c.Request.URL.Query(), &params.TiltWindow)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tiltWindow: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetPingResponseObject interface {
-	VisitGetPingResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "tiltThreshold" -------------
 
-type GetPing200JSONResponse Pong
+	err = runtime.BindQueryParameter("form", true, false, "tiltThreshold", c.Request.URL.Query(), &params.TiltThreshold)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tiltThreshold: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetPing200JSONResponse) VisitGetPingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetStreaks(c, params)
 }
 
-type RefreshTokenRequestObject struct {
-	Body *RefreshTokenJSONRequestBody
-}
+// GetTeammates operation middleware
+func (siw *ServerInterfaceWrapper) GetTeammates(c *gin.Context) {
 
-type RefreshTokenResponseObject interface {
-	VisitRefreshTokenResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type RefreshToken200JSONResponse AuthResponse
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeammatesParams
 
-func (response RefreshToken200JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Required query parameter "characterId" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-type SearchRequestObject struct {
-	Body *SearchJSONRequestBody
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type SearchResponseObject interface {
-	VisitSearchResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type Search200JSONResponse struct {
-	HasMore bool               `json:"hasMore"`
-	Results []SearchUserResult `json:"results"`
-}
+	// ------------- Optional query parameter "gameMode" -------------
 
-func (response Search200JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "filter" -------------
 
-type GetSessionsRequestObject struct {
-	Params GetSessionsParams
-}
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetSessionsResponseObject interface {
-	VisitGetSessionsResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "minimumMatches" -------------
 
-type GetSessions200JSONResponse []Session
+	err = runtime.BindQueryParameter("form", true, false, "minimumMatches", c.Request.URL.Query(), &params.MinimumMatches)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter minimumMatches: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetSessions200JSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "count" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type StartSessionRequestObject struct {
-	Params StartSessionParams
-	Body   *StartSessionJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type StartSessionResponseObject interface {
-	VisitStartSessionResponse(w http.ResponseWriter) error
+	siw.Handler.GetTeammates(c, params)
 }
 
-type StartSession201JSONResponse Session
+// GetTrend operation middleware
+func (siw *ServerInterfaceWrapper) GetTrend(c *gin.Context) {
 
-func (response StartSession201JSONResponse) VisitStartSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrendParams
 
-type StartSession400JSONResponse struct {
-	Message string `json:"message"`
-}
+	// ------------- Required query parameter "characterId" -------------
 
-func (response StartSession400JSONResponse) VisitStartSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type GetSessionRequestObject struct {
-	SessionId string `json:"sessionId"`
-}
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetSessionResponseObject interface {
-	VisitGetSessionResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "gameMode" -------------
 
-type GetSession200JSONResponse Session
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetSession200JSONResponse) VisitGetSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "filter" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type UpdateSessionRequestObject struct {
-	SessionID string `json:"sessionId"`
-	Params    UpdateSessionParams
-	Body      *UpdateSessionJSONRequestBody
-}
+	// ------------- Optional query parameter "snapshotId" -------------
 
-type UpdateSessionResponseObject interface {
-	VisitUpdateSessionResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "snapshotId", c.Request.URL.Query(), &params.SnapshotID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type UpdateSession200JSONResponse Session
+	// ------------- Optional query parameter "bucket" -------------
 
-func (response UpdateSession200JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "bucket", c.Request.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter bucket: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "window" -------------
 
-type GetSessionAggregatesRequestObject struct {
-	SessionId string `json:"sessionId"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "window", c.Request.URL.Query(), &params.Window)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter window: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetSessionAggregatesResponseObject interface {
-	VisitGetSessionAggregatesResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetSessionAggregates200JSONResponse struct {
-	Aggregates []Aggregate                  `json:"aggregates"`
-	Snapshots  map[string]CharacterSnapshot `json:"snapshots"`
+	siw.Handler.GetTrend(c, params)
 }
 
-func (response GetSessionAggregates200JSONResponse) VisitGetSessionAggregatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTrialsCards operation middleware
+func (siw *ServerInterfaceWrapper) GetTrialsCards(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type CompleteSessionRequestObject struct {
-	SessionId string `json:"sessionId"`
-	Params    CompleteSessionParams
-	Body      *CompleteSessionJSONRequestBody
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTrialsCardsParams
 
-type CompleteSessionResponseObject interface {
-	VisitCompleteSessionResponse(w http.ResponseWriter) error
-}
+	// ------------- Required query parameter "characterId" -------------
 
-type CompleteSession200JSONResponse Session
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-func (response CompleteSession200JSONResponse) VisitCompleteSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetSnapshotsRequestObject struct {
-	Params GetSnapshotsParams
-}
+	// ------------- Optional query parameter "filter" -------------
 
-type GetSnapshotsResponseObject interface {
-	VisitGetSnapshotsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetSnapshots200JSONResponse []CharacterSnapshot
+	// ------------- Optional query parameter "count" -------------
 
-func (response GetSnapshots200JSONResponse) VisitGetSnapshotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type CreateSnapshotRequestObject struct {
-	Params CreateSnapshotParams
-	Body   *CreateSnapshotJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type CreateSnapshotResponseObject interface {
-	VisitCreateSnapshotResponse(w http.ResponseWriter) error
+	siw.Handler.GetTrialsCards(c, params)
 }
 
-type CreateSnapshot201JSONResponse CharacterSnapshot
+// GetWeaponTypePerformance operation middleware
+func (siw *ServerInterfaceWrapper) GetWeaponTypePerformance(c *gin.Context) {
 
-func (response CreateSnapshot201JSONResponse) VisitCreateSnapshotResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWeaponTypePerformanceParams
 
-type GetSnapshotRequestObject struct {
-	SnapshotID string `json:"snapshotId"`
-}
+	// ------------- Required query parameter "characterId" -------------
 
-type GetSnapshotResponseObject interface {
-	VisitGetSnapshotResponse(w http.ResponseWriter) error
-}
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-type GetSnapshot200JSONResponse CharacterSnapshot
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-func (response GetSnapshot200JSONResponse) VisitGetSnapshotResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "gameMode" -------------
 
-type UpdateSnapshotRequestObject struct {
-	SnapshotID string `json:"snapshotId"`
-	Params     UpdateSnapshotParams
-	Body       *UpdateSnapshotJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type UpdateSnapshotResponseObject interface {
-	VisitUpdateSnapshotResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "filter" -------------
 
-type UpdateSnapshot200JSONResponse CharacterSnapshot
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateSnapshot200JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetWeaponTypePerformance(c, params)
 }
 
-type UpdateSnapshot400JSONResponse OneTrickError
+// GetWeaponPerformance operation middleware
+func (siw *ServerInterfaceWrapper) GetWeaponPerformance(c *gin.Context) {
 
-func (response UpdateSnapshot400JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWeaponPerformanceParams
 
-type UpdateSnapshot401JSONResponse OneTrickError
+	// ------------- Required query parameter "characterId" -------------
 
-func (response UpdateSnapshot401JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type UpdateSnapshot404JSONResponse OneTrickError
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateSnapshot404JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "gameMode" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type UpdateSnapshot500JSONResponse OneTrickError
+	// ------------- Optional query parameter "filter" -------------
 
-func (response UpdateSnapshot500JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "byInstance" -------------
 
-type GetSnapshotAggregatesRequestObject struct {
-	SnapshotID string `json:"snapshotId"`
-	Params     GetSnapshotAggregatesParams
-}
+	err = runtime.BindQueryParameter("form", true, false, "byInstance", c.Request.URL.Query(), &params.ByInstance)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter byInstance: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetSnapshotAggregatesResponseObject interface {
-	VisitGetSnapshotAggregatesResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetSnapshotAggregates200JSONResponse []Aggregate
+	siw.Handler.GetWeaponPerformance(c, params)
+}
 
-func (response GetSnapshotAggregates200JSONResponse) VisitGetSnapshotAggregatesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetPerkPerformance operation middleware
+func (siw *ServerInterfaceWrapper) GetPerkPerformance(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type MergeSnapshotsRequestObject struct {
-	SnapshotID string `json:"snapshotId"`
-	Params     MergeSnapshotsParams
-	Body       *MergeSnapshotsJSONRequestBody
-}
+	// ------------- Path parameter "weaponHash" -------------
+	var weaponHash int64
 
-type MergeSnapshotsResponseObject interface {
-	VisitMergeSnapshotsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "weaponHash", c.Param("weaponHash"), &weaponHash, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter weaponHash: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type MergeSnapshots200JSONResponse bool
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPerkPerformanceParams
 
-func (response MergeSnapshots200JSONResponse) VisitMergeSnapshotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Required query parameter "characterId" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := c.Query("characterId"); paramValue != "" {
 
-type MergeSnapshots500JSONResponse struct {
-	Message string `json:"message"`
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-func (response MergeSnapshots500JSONResponse) VisitMergeSnapshotsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "gameMode" -------------
 
-type GetUserRequestObject struct {
-	UserID string `json:"userId"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetUserResponseObject interface {
-	VisitGetUserResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "filter" -------------
 
-type GetUser200JSONResponse Profile
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetPerkPerformance(c, weaponHash, params)
 }
 
-type GetUser503JSONResponse struct {
-	// Message User friendly description of the error
-	Message string        `json:"message"`
-	Status  InternalError `json:"status"`
-}
+// GetPing operation middleware
+func (siw *ServerInterfaceWrapper) GetPing(c *gin.Context) {
 
-func (response GetUser503JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(503)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetPing(c)
 }
 
-type GetUserSessionsRequestObject struct {
-	UserID string `json:"userId"`
-	Params GetUserSessionsParams
-}
+// GetSharedContent operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedContent(c *gin.Context) {
 
-type GetUserSessionsResponseObject interface {
-	VisitGetUserSessionsResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetUserSessions200JSONResponse []Session
+	// ------------- Path parameter "token" -------------
+	var token string
 
-func (response GetUserSessions200JSONResponse) VisitGetUserSessionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSharedContent(c, token)
+}
+
+// RefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) RefreshToken(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RefreshToken(c)
+}
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.Search(c)
+}
+
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSessionsParams
+
+	// ------------- Required query parameter "count" -------------
+
+	if paramValue := c.Query("count"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument count is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "page" -------------
+
+	if paramValue := c.Query("page"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument page is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "characterId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "userId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userId", c.Request.URL.Query(), &params.UserID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSessions(c, params)
+}
+
+// StartSession operation middleware
+func (siw *ServerInterfaceWrapper) StartSession(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartSessionParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-Membership-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
+		var XMembershipID XMembershipID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XMembershipID = XMembershipID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StartSession(c, params)
+}
+
+// GetSession operation middleware
+func (siw *ServerInterfaceWrapper) GetSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSession(c, sessionId)
+}
+
+// UpdateSession operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSessionParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-Membership-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
+		var XMembershipID XMembershipID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XMembershipID = XMembershipID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateSession(c, sessionID, params)
+}
+
+// GetSessionAggregates operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionAggregates(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSessionAggregatesParams
+
+	// ------------- Optional query parameter "tiltWindow" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tiltWindow", c.Request.URL.Query(), &params.TiltWindow)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tiltWindow: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tiltThreshold" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tiltThreshold", c.Request.URL.Query(), &params.TiltThreshold)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tiltThreshold: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSessionAggregates(c, sessionId, params)
+}
+
+// CompleteSession operation middleware
+func (siw *ServerInterfaceWrapper) CompleteSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", c.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sessionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CompleteSessionParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-Membership-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
+		var XMembershipID XMembershipID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XMembershipID = XMembershipID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CompleteSession(c, sessionId, params)
+}
+
+// GetShareLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetShareLinks(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetShareLinksParams
+
+	// ------------- Optional query parameter "resourceId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceId", c.Request.URL.Query(), &params.ResourceID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resourceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetShareLinks(c, params)
+}
+
+// CreateShareLink operation middleware
+func (siw *ServerInterfaceWrapper) CreateShareLink(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateShareLinkParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateShareLink(c, params)
+}
+
+// RevokeShareLink operation middleware
+func (siw *ServerInterfaceWrapper) RevokeShareLink(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", c.Param("token"), &token, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RevokeShareLinkParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeShareLink(c, token, params)
+}
+
+// GetSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) GetSnapshots(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSnapshotsParams
+
+	// ------------- Required query parameter "count" -------------
+
+	if paramValue := c.Query("count"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument count is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "page" -------------
+
+	if paramValue := c.Query("page"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument page is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "characterId" -------------
+
+	if paramValue := c.Query("characterId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search", c.Request.URL.Query(), &params.Search)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", c.Request.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "favorite" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "favorite", c.Request.URL.Query(), &params.Favorite)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter favorite: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "archived", c.Request.URL.Query(), &params.Archived)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter archived: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "weaponHash" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "weaponHash", c.Request.URL.Query(), &params.WeaponHash)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter weaponHash: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "damageType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "damageType", c.Request.URL.Query(), &params.DamageType)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter damageType: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSnapshots(c, params)
+}
+
+// CreateSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) CreateSnapshot(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateSnapshotParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-Membership-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
+		var XMembershipID XMembershipID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XMembershipID = XMembershipID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateSnapshot(c, params)
+}
+
+// GetSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) GetSnapshot(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Param("snapshotId"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSnapshot(c, snapshotID)
+}
+
+// UpdateSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSnapshot(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Param("snapshotId"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSnapshotParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateSnapshot(c, snapshotID, params)
+}
+
+// GetSnapshotAggregates operation middleware
+func (siw *ServerInterfaceWrapper) GetSnapshotAggregates(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Param("snapshotId"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSnapshotAggregatesParams
+
+	// ------------- Optional query parameter "gameMode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gameMode", c.Request.URL.Query(), &params.GameMode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gameMode: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("deepObject", true, false, "filter", c.Request.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter filter: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSnapshotAggregates(c, snapshotID, params)
+}
+
+// MergeSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) MergeSnapshots(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Param("snapshotId"), &snapshotID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter snapshotId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MergeSnapshotsParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MergeSnapshots(c, snapshotID, params)
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUser(c, userID)
+}
+
+// GetUserDigests operation middleware
+func (siw *ServerInterfaceWrapper) GetUserDigests(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserDigestsParams
+
+	// ------------- Optional query parameter "count" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserDigests(c, userID, params)
+}
+
+// GetUserSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetUserSessions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserSessionsParams
+
+	// ------------- Required query parameter "count" -------------
+
+	if paramValue := c.Query("count"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument count is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "count", c.Request.URL.Query(), &params.Count)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter count: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "page" -------------
+
+	if paramValue := c.Query("page"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument page is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "characterId" -------------
+
+	if paramValue := c.Query("characterId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument characterId is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "characterId", c.Request.URL.Query(), &params.CharacterID)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter characterId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUserSessions(c, userID, params)
+}
+
+// StartUserSession operation middleware
+func (siw *ServerInterfaceWrapper) StartUserSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartUserSessionParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-ID")]; found {
+		var XUserID XUserID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-ID", valueList[0], &XUserID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserID = XUserID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-Membership-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Membership-ID")]; found {
+		var XMembershipID XMembershipID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-Membership-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Membership-ID", valueList[0], &XMembershipID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-Membership-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XMembershipID = XMembershipID
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-Membership-ID is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StartUserSession(c, userID, params)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/activities", wrapper.GetActivities)
+	router.GET(options.BaseURL+"/activities/:activityId", wrapper.GetActivity)
+	router.POST(options.BaseURL+"/admin/backfill-aggregate-data", wrapper.BackfillAggregateData)
+	router.POST(options.BaseURL+"/admin/backfill-character-ids", wrapper.BackfillAllUsersCharacterIds)
+	router.POST(options.BaseURL+"/admin/backfill-lobby-strength", wrapper.BackfillLobbyStrength)
+	router.POST(options.BaseURL+"/admin/backfill-snapshot-base-info", wrapper.BackfillSnapshotInfo)
+	router.POST(options.BaseURL+"/admin/generate-digests", wrapper.GenerateDigests)
+	router.POST(options.BaseURL+"/admin/generate-meta-reports", wrapper.GenerateMetaReports)
+	router.POST(options.BaseURL+"/admin/rebuild-rollups", wrapper.RebuildRollups)
+	router.GET(options.BaseURL+"/fireteam", wrapper.GetFireteam)
+	router.GET(options.BaseURL+"/groups", wrapper.GetGroups)
+	router.POST(options.BaseURL+"/groups", wrapper.CreateGroup)
+	router.DELETE(options.BaseURL+"/groups/:groupId", wrapper.DeleteGroup)
+	router.PUT(options.BaseURL+"/groups/:groupId", wrapper.UpdateGroup)
+	router.POST(options.BaseURL+"/groups/:groupId/invitation", wrapper.RespondToGroupInvitation)
+	router.GET(options.BaseURL+"/leaderboards", wrapper.GetLeaderboard)
+	router.POST(options.BaseURL+"/login", wrapper.Login)
+	router.GET(options.BaseURL+"/metrics/abilities", wrapper.GetAbilityKills)
+	router.GET(options.BaseURL+"/metrics/best-performing-loadouts", wrapper.GetBestPerformingLoadouts)
+	router.GET(options.BaseURL+"/metrics/class-stats", wrapper.GetClassStatAnalysis)
+	router.GET(options.BaseURL+"/metrics/compare", wrapper.CompareLoadouts)
+	router.GET(options.BaseURL+"/metrics/maps", wrapper.GetMapPerformance)
+	router.GET(options.BaseURL+"/metrics/meta", wrapper.GetMetaReport)
+	router.GET(options.BaseURL+"/metrics/most-used-loadouts", wrapper.GetMostUsedLoadouts)
+	router.GET(options.BaseURL+"/metrics/rollups", wrapper.GetRollups)
+	router.GET(options.BaseURL+"/metrics/schedule", wrapper.GetSchedule)
+	router.GET(options.BaseURL+"/metrics/streaks", wrapper.GetStreaks)
+	router.GET(options.BaseURL+"/metrics/teammates", wrapper.GetTeammates)
+	router.GET(options.BaseURL+"/metrics/trend", wrapper.GetTrend)
+	router.GET(options.BaseURL+"/metrics/trials-cards", wrapper.GetTrialsCards)
+	router.GET(options.BaseURL+"/metrics/weapon-types", wrapper.GetWeaponTypePerformance)
+	router.GET(options.BaseURL+"/metrics/weapons", wrapper.GetWeaponPerformance)
+	router.GET(options.BaseURL+"/metrics/weapons/:weaponHash/perks", wrapper.GetPerkPerformance)
+	router.GET(options.BaseURL+"/ping", wrapper.GetPing)
+	router.GET(options.BaseURL+"/public/shares/:token", wrapper.GetSharedContent)
+	router.POST(options.BaseURL+"/refresh", wrapper.RefreshToken)
+	router.POST(options.BaseURL+"/search", wrapper.Search)
+	router.GET(options.BaseURL+"/sessions", wrapper.GetSessions)
+	router.POST(options.BaseURL+"/sessions", wrapper.StartSession)
+	router.GET(options.BaseURL+"/sessions/:sessionId", wrapper.GetSession)
+	router.PUT(options.BaseURL+"/sessions/:sessionId", wrapper.UpdateSession)
+	router.GET(options.BaseURL+"/sessions/:sessionId/aggregates", wrapper.GetSessionAggregates)
+	router.PUT(options.BaseURL+"/sessions/:sessionId/complete", wrapper.CompleteSession)
+	router.GET(options.BaseURL+"/shares", wrapper.GetShareLinks)
+	router.POST(options.BaseURL+"/shares", wrapper.CreateShareLink)
+	router.DELETE(options.BaseURL+"/shares/:token", wrapper.RevokeShareLink)
+	router.GET(options.BaseURL+"/snapshots", wrapper.GetSnapshots)
+	router.POST(options.BaseURL+"/snapshots", wrapper.CreateSnapshot)
+	router.GET(options.BaseURL+"/snapshots/:snapshotId", wrapper.GetSnapshot)
+	router.PUT(options.BaseURL+"/snapshots/:snapshotId", wrapper.UpdateSnapshot)
+	router.GET(options.BaseURL+"/snapshots/:snapshotId/aggregates", wrapper.GetSnapshotAggregates)
+	router.POST(options.BaseURL+"/snapshots/:snapshotId/merge", wrapper.MergeSnapshots)
+	router.GET(options.BaseURL+"/users/:userId", wrapper.GetUser)
+	router.GET(options.BaseURL+"/users/:userId/digests", wrapper.GetUserDigests)
+	router.GET(options.BaseURL+"/users/:userId/sessions", wrapper.GetUserSessions)
+	router.POST(options.BaseURL+"/users/:userId/sessions", wrapper.StartUserSession)
+}
+
+type GetActivitiesRequestObject struct {
+	Params GetActivitiesParams
+}
+
+type GetActivitiesResponseObject interface {
+	VisitGetActivitiesResponse(w http.ResponseWriter) error
+}
+
+type GetActivities200JSONResponse []DetailActivity
+
+func (response GetActivities200JSONResponse) VisitGetActivitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivityRequestObject struct {
+	ActivityID string `json:"activityId"`
+	Params     GetActivityParams
+}
+
+type GetActivityResponseObject interface {
+	VisitGetActivityResponse(w http.ResponseWriter) error
+}
+
+type GetActivity200JSONResponse struct {
+	Activity        ActivityHistory              `firestore:"activityHistory" json:"activity"`
+	Aggregate       *Aggregate                   `json:"aggregate,omitempty"`
+	PostGameEntries *[]map[string]interface{}    `json:"postGameEntries,omitempty"`
+	Snapshots       map[string]CharacterSnapshot `json:"snapshots"`
+	Teams           []Team                       `json:"teams"`
+	Users           map[string]User              `json:"users"`
+}
+
+func (response GetActivity200JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity400JSONResponse OneTrickError
+
+func (response GetActivity400JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity404JSONResponse OneTrickError
+
+func (response GetActivity404JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetActivity500JSONResponse OneTrickError
+
+func (response GetActivity500JSONResponse) VisitGetActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BackfillAggregateDataRequestObject struct {
+}
+
+type BackfillAggregateDataResponseObject interface {
+	VisitBackfillAggregateDataResponse(w http.ResponseWriter) error
+}
+
+type BackfillAggregateData200JSONResponse struct {
+	Failed  int32 `json:"failed"`
+	Updated int32 `json:"updated"`
+}
+
+func (response BackfillAggregateData200JSONResponse) VisitBackfillAggregateDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BackfillAllUsersCharacterIdsRequestObject struct {
+}
+
+type BackfillAllUsersCharacterIdsResponseObject interface {
+	VisitBackfillAllUsersCharacterIdsResponse(w http.ResponseWriter) error
+}
+
+type BackfillAllUsersCharacterIds200JSONResponse struct {
+	Failed  int32 `json:"failed"`
+	Updated int32 `json:"updated"`
+}
+
+func (response BackfillAllUsersCharacterIds200JSONResponse) VisitBackfillAllUsersCharacterIdsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BackfillLobbyStrengthRequestObject struct {
+}
+
+type BackfillLobbyStrengthResponseObject interface {
+	VisitBackfillLobbyStrengthResponse(w http.ResponseWriter) error
+}
+
+type BackfillLobbyStrength200JSONResponse struct {
+	Failed  int32 `json:"failed"`
+	Updated int32 `json:"updated"`
+}
+
+func (response BackfillLobbyStrength200JSONResponse) VisitBackfillLobbyStrengthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BackfillSnapshotInfoRequestObject struct {
+}
+
+type BackfillSnapshotInfoResponseObject interface {
+	VisitBackfillSnapshotInfoResponse(w http.ResponseWriter) error
+}
+
+type BackfillSnapshotInfo200JSONResponse struct {
+	Failed  int32 `json:"failed"`
+	Updated int32 `json:"updated"`
+}
+
+func (response BackfillSnapshotInfo200JSONResponse) VisitBackfillSnapshotInfoResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateDigestsRequestObject struct {
+	Params GenerateDigestsParams
+}
+
+type GenerateDigestsResponseObject interface {
+	VisitGenerateDigestsResponse(w http.ResponseWriter) error
+}
+
+type GenerateDigests200JSONResponse struct {
+	Failed    int32 `json:"failed"`
+	Generated int32 `json:"generated"`
+}
+
+func (response GenerateDigests200JSONResponse) VisitGenerateDigestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateMetaReportsRequestObject struct {
+}
+
+type GenerateMetaReportsResponseObject interface {
+	VisitGenerateMetaReportsResponse(w http.ResponseWriter) error
+}
+
+type GenerateMetaReports200JSONResponse struct {
+	Generated int32 `json:"generated"`
+}
+
+func (response GenerateMetaReports200JSONResponse) VisitGenerateMetaReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GenerateMetaReports500JSONResponse OneTrickError
+
+func (response GenerateMetaReports500JSONResponse) VisitGenerateMetaReportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RebuildRollupsRequestObject struct {
+	Params RebuildRollupsParams
+}
+
+type RebuildRollupsResponseObject interface {
+	VisitRebuildRollupsResponse(w http.ResponseWriter) error
+}
+
+type RebuildRollups200JSONResponse struct {
+	Failed  int32 `json:"failed"`
+	Updated int32 `json:"updated"`
+}
+
+func (response RebuildRollups200JSONResponse) VisitRebuildRollupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFireteamRequestObject struct {
+	Params GetFireteamParams
+}
+
+type GetFireteamResponseObject interface {
+	VisitGetFireteamResponse(w http.ResponseWriter) error
+}
+
+type GetFireteam200JSONResponse []FireteamMember
+
+func (response GetFireteam200JSONResponse) VisitGetFireteamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFireteam503JSONResponse struct {
+	// Message User friendly description of the error
+	Message string        `json:"message"`
+	Status  InternalError `json:"status"`
+}
+
+func (response GetFireteam503JSONResponse) VisitGetFireteamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroupsRequestObject struct {
+	Params GetGroupsParams
+}
+
+type GetGroupsResponseObject interface {
+	VisitGetGroupsResponse(w http.ResponseWriter) error
+}
+
+type GetGroups200JSONResponse []Group
+
+func (response GetGroups200JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetGroups500JSONResponse OneTrickError
+
+func (response GetGroups500JSONResponse) VisitGetGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroupRequestObject struct {
+	Params CreateGroupParams
+	Body   *CreateGroupJSONRequestBody
+}
+
+type CreateGroupResponseObject interface {
+	VisitCreateGroupResponse(w http.ResponseWriter) error
+}
+
+type CreateGroup201JSONResponse Group
+
+func (response CreateGroup201JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup400JSONResponse OneTrickError
+
+func (response CreateGroup400JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateGroup500JSONResponse OneTrickError
+
+func (response CreateGroup500JSONResponse) VisitCreateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroupRequestObject struct {
+	GroupID string `json:"groupId"`
+	Params  DeleteGroupParams
+}
+
+type DeleteGroupResponseObject interface {
+	VisitDeleteGroupResponse(w http.ResponseWriter) error
+}
+
+type DeleteGroup200JSONResponse Group
+
+func (response DeleteGroup200JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup401JSONResponse OneTrickError
+
+func (response DeleteGroup401JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup404JSONResponse OneTrickError
+
+func (response DeleteGroup404JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteGroup500JSONResponse OneTrickError
+
+func (response DeleteGroup500JSONResponse) VisitDeleteGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroupRequestObject struct {
+	GroupID string `json:"groupId"`
+	Params  UpdateGroupParams
+	Body    *UpdateGroupJSONRequestBody
+}
+
+type UpdateGroupResponseObject interface {
+	VisitUpdateGroupResponse(w http.ResponseWriter) error
+}
+
+type UpdateGroup200JSONResponse Group
+
+func (response UpdateGroup200JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup400JSONResponse OneTrickError
+
+func (response UpdateGroup400JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup401JSONResponse OneTrickError
+
+func (response UpdateGroup401JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup404JSONResponse OneTrickError
+
+func (response UpdateGroup404JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateGroup500JSONResponse OneTrickError
+
+func (response UpdateGroup500JSONResponse) VisitUpdateGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RespondToGroupInvitationRequestObject struct {
+	GroupID string `json:"groupId"`
+	Params  RespondToGroupInvitationParams
+	Body    *RespondToGroupInvitationJSONRequestBody
+}
+
+type RespondToGroupInvitationResponseObject interface {
+	VisitRespondToGroupInvitationResponse(w http.ResponseWriter) error
+}
+
+type RespondToGroupInvitation200JSONResponse Group
+
+func (response RespondToGroupInvitation200JSONResponse) VisitRespondToGroupInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RespondToGroupInvitation404JSONResponse OneTrickError
+
+func (response RespondToGroupInvitation404JSONResponse) VisitRespondToGroupInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RespondToGroupInvitation500JSONResponse OneTrickError
+
+func (response RespondToGroupInvitation500JSONResponse) VisitRespondToGroupInvitationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboardRequestObject struct {
+	Params GetLeaderboardParams
+}
+
+type GetLeaderboardResponseObject interface {
+	VisitGetLeaderboardResponse(w http.ResponseWriter) error
+}
+
+type GetLeaderboard200JSONResponse Leaderboard
+
+func (response GetLeaderboard200JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboard400JSONResponse OneTrickError
+
+func (response GetLeaderboard400JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboard401JSONResponse OneTrickError
+
+func (response GetLeaderboard401JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboard404JSONResponse OneTrickError
+
+func (response GetLeaderboard404JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLeaderboard500JSONResponse OneTrickError
+
+func (response GetLeaderboard500JSONResponse) VisitGetLeaderboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse AuthResponse
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAbilityKillsRequestObject struct {
+	Params GetAbilityKillsParams
+}
+
+type GetAbilityKillsResponseObject interface {
+	VisitGetAbilityKillsResponse(w http.ResponseWriter) error
+}
+
+type GetAbilityKills200JSONResponse struct {
+	// Character Kills made with abilities rather than weapons. Shares are out of every kill, so totalShare is how much of a player's damage output comes from their subclass build.
+	Character AbilityKills            `json:"character"`
+	Snapshots map[string]AbilityKills `json:"snapshots"`
+}
+
+func (response GetAbilityKills200JSONResponse) VisitGetAbilityKillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAbilityKills500JSONResponse OneTrickError
+
+func (response GetAbilityKills500JSONResponse) VisitGetAbilityKillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBestPerformingLoadoutsRequestObject struct {
+	Params GetBestPerformingLoadoutsParams
+}
+
+type GetBestPerformingLoadoutsResponseObject interface {
+	VisitGetBestPerformingLoadoutsResponse(w http.ResponseWriter) error
+}
+
+type GetBestPerformingLoadouts200JSONResponse struct {
+	Confidence map[string]LoadoutConfidence `json:"confidence"`
+	Count      map[string]int               `json:"count"`
+	Items      []CharacterSnapshot          `json:"items"`
+	Stats      map[string]PlayerStats       `json:"stats"`
+}
+
+func (response GetBestPerformingLoadouts200JSONResponse) VisitGetBestPerformingLoadoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClassStatAnalysisRequestObject struct {
+	Params GetClassStatAnalysisParams
+}
+
+type GetClassStatAnalysisResponseObject interface {
+	VisitGetClassStatAnalysisResponse(w http.ResponseWriter) error
+}
+
+type GetClassStatAnalysis200JSONResponse struct {
+	Items []ClassStatAnalysis `json:"items"`
+}
+
+func (response GetClassStatAnalysis200JSONResponse) VisitGetClassStatAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetClassStatAnalysis500JSONResponse OneTrickError
+
+func (response GetClassStatAnalysis500JSONResponse) VisitGetClassStatAnalysisResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareLoadoutsRequestObject struct {
+	Params CompareLoadoutsParams
+}
+
+type CompareLoadoutsResponseObject interface {
+	VisitCompareLoadoutsResponse(w http.ResponseWriter) error
+}
+
+type CompareLoadouts200JSONResponse LoadoutComparison
+
+func (response CompareLoadouts200JSONResponse) VisitCompareLoadoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareLoadouts400JSONResponse OneTrickError
+
+func (response CompareLoadouts400JSONResponse) VisitCompareLoadoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareLoadouts404JSONResponse OneTrickError
+
+func (response CompareLoadouts404JSONResponse) VisitCompareLoadoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompareLoadouts500JSONResponse OneTrickError
+
+func (response CompareLoadouts500JSONResponse) VisitCompareLoadoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMapPerformanceRequestObject struct {
+	Params GetMapPerformanceParams
+}
+
+type GetMapPerformanceResponseObject interface {
+	VisitGetMapPerformanceResponse(w http.ResponseWriter) error
+}
+
+type GetMapPerformance200JSONResponse struct {
+	Items []MapPerformance `json:"items"`
+}
+
+func (response GetMapPerformance200JSONResponse) VisitGetMapPerformanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMapPerformance500JSONResponse OneTrickError
+
+func (response GetMapPerformance500JSONResponse) VisitGetMapPerformanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetaReportRequestObject struct {
+	Params GetMetaReportParams
+}
+
+type GetMetaReportResponseObject interface {
+	VisitGetMetaReportResponse(w http.ResponseWriter) error
+}
+
+type GetMetaReport200JSONResponse MetaReport
+
+func (response GetMetaReport200JSONResponse) VisitGetMetaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetaReport404JSONResponse OneTrickError
+
+func (response GetMetaReport404JSONResponse) VisitGetMetaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetaReport500JSONResponse OneTrickError
+
+func (response GetMetaReport500JSONResponse) VisitGetMetaReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMostUsedLoadoutsRequestObject struct {
+	Params GetMostUsedLoadoutsParams
+}
+
+type GetMostUsedLoadoutsResponseObject interface {
+	VisitGetMostUsedLoadoutsResponse(w http.ResponseWriter) error
+}
+
+type GetMostUsedLoadouts200JSONResponse struct {
+	Count map[string]int         `json:"count"`
+	Items []CharacterSnapshot    `json:"items"`
+	Stats map[string]PlayerStats `json:"stats"`
+}
+
+func (response GetMostUsedLoadouts200JSONResponse) VisitGetMostUsedLoadoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMostUsedLoadouts500JSONResponse OneTrickError
+
+func (response GetMostUsedLoadouts500JSONResponse) VisitGetMostUsedLoadoutsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRollupsRequestObject struct {
+	Params GetRollupsParams
+}
+
+type GetRollupsResponseObject interface {
+	VisitGetRollupsResponse(w http.ResponseWriter) error
+}
+
+type GetRollups200JSONResponse struct {
+	// Character Incrementally maintained totals of a character's games, or of the games played with one of their snapshots. Read by the stats endpoints instead of every aggregate.
+	Character StatsRollup   `json:"character"`
+	Snapshots []StatsRollup `json:"snapshots"`
+}
+
+func (response GetRollups200JSONResponse) VisitGetRollupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRollups500JSONResponse OneTrickError
+
+func (response GetRollups500JSONResponse) VisitGetRollupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetScheduleRequestObject struct {
+	Params GetScheduleParams
+}
+
+type GetScheduleResponseObject interface {
+	VisitGetScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetSchedule200JSONResponse Schedule
+
+func (response GetSchedule200JSONResponse) VisitGetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchedule400JSONResponse OneTrickError
+
+func (response GetSchedule400JSONResponse) VisitGetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchedule500JSONResponse OneTrickError
+
+func (response GetSchedule500JSONResponse) VisitGetScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStreaksRequestObject struct {
+	Params GetStreaksParams
+}
+
+type GetStreaksResponseObject interface {
+	VisitGetStreaksResponse(w http.ResponseWriter) error
+}
+
+type GetStreaks200JSONResponse struct {
+	Modes map[string]StreakSummary `json:"modes"`
+
+	// Overall Win and loss streaks over a set of matches, along with the player's tilt status.
+	Overall StreakSummary `json:"overall"`
+}
+
+func (response GetStreaks200JSONResponse) VisitGetStreaksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStreaks500JSONResponse OneTrickError
+
+func (response GetStreaks500JSONResponse) VisitGetStreaksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeammatesRequestObject struct {
+	Params GetTeammatesParams
+}
+
+type GetTeammatesResponseObject interface {
+	VisitGetTeammatesResponse(w http.ResponseWriter) error
+}
+
+type GetTeammates200JSONResponse struct {
+	Items []Teammate `json:"items"`
+
+	// Solo Matches without another OneTrick user in the character's fireteam. Fireteams with players that don't use OneTrick count as solo, their fireteams aren't known.
+	Solo FireteamStats `json:"solo"`
+}
+
+func (response GetTeammates200JSONResponse) VisitGetTeammatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeammates500JSONResponse OneTrickError
+
+func (response GetTeammates500JSONResponse) VisitGetTeammatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrendRequestObject struct {
+	Params GetTrendParams
+}
+
+type GetTrendResponseObject interface {
+	VisitGetTrendResponse(w http.ResponseWriter) error
+}
+
+type GetTrend200JSONResponse struct {
+	// Bucket How matches are grouped into points of a trend. day and week use UTC calendar days and weeks starting Monday, session uses the session the match was checked in to, and rolling is a moving window of the last N matches.
+	Bucket TrendBucket  `json:"bucket"`
+	Items  []TrendPoint `json:"items"`
+}
+
+func (response GetTrend200JSONResponse) VisitGetTrendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrend500JSONResponse OneTrickError
+
+func (response GetTrend500JSONResponse) VisitGetTrendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrialsCardsRequestObject struct {
+	Params GetTrialsCardsParams
+}
+
+type GetTrialsCardsResponseObject interface {
+	VisitGetTrialsCardsResponse(w http.ResponseWriter) error
+}
+
+type GetTrialsCards200JSONResponse TrialsCardSummary
+
+func (response GetTrialsCards200JSONResponse) VisitGetTrialsCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrialsCards500JSONResponse OneTrickError
+
+func (response GetTrialsCards500JSONResponse) VisitGetTrialsCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWeaponTypePerformanceRequestObject struct {
+	Params GetWeaponTypePerformanceParams
+}
+
+type GetWeaponTypePerformanceResponseObject interface {
+	VisitGetWeaponTypePerformanceResponse(w http.ResponseWriter) error
+}
+
+type GetWeaponTypePerformance200JSONResponse WeaponTypeBreakdown
+
+func (response GetWeaponTypePerformance200JSONResponse) VisitGetWeaponTypePerformanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWeaponTypePerformance500JSONResponse OneTrickError
+
+func (response GetWeaponTypePerformance500JSONResponse) VisitGetWeaponTypePerformanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWeaponPerformanceRequestObject struct {
+	Params GetWeaponPerformanceParams
+}
+
+type GetWeaponPerformanceResponseObject interface {
+	VisitGetWeaponPerformanceResponse(w http.ResponseWriter) error
+}
+
+type GetWeaponPerformance200JSONResponse struct {
+	Items []WeaponPerformance `json:"items"`
+
+	// Matches Total matches considered
+	Matches int `json:"matches"`
+}
+
+func (response GetWeaponPerformance200JSONResponse) VisitGetWeaponPerformanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWeaponPerformance500JSONResponse OneTrickError
+
+func (response GetWeaponPerformance500JSONResponse) VisitGetWeaponPerformanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPerkPerformanceRequestObject struct {
+	WeaponHash int64 `json:"weaponHash"`
+	Params     GetPerkPerformanceParams
+}
+
+type GetPerkPerformanceResponseObject interface {
+	VisitGetPerkPerformanceResponse(w http.ResponseWriter) error
+}
+
+type GetPerkPerformance200JSONResponse struct {
+	Items []PerkPerformance `json:"items"`
+
+	// Matches Number of matches the weapon was equipped in
+	Matches int `json:"matches"`
+}
+
+func (response GetPerkPerformance200JSONResponse) VisitGetPerkPerformanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPerkPerformance500JSONResponse OneTrickError
+
+func (response GetPerkPerformance500JSONResponse) VisitGetPerkPerformanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPingRequestObject struct {
+}
+
+type GetPingResponseObject interface {
+	VisitGetPingResponse(w http.ResponseWriter) error
+}
+
+type GetPing200JSONResponse Pong
+
+func (response GetPing200JSONResponse) VisitGetPingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedContentRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetSharedContentResponseObject interface {
+	VisitGetSharedContentResponse(w http.ResponseWriter) error
+}
+
+type GetSharedContent200JSONResponse struct {
+	Aggregates *[]Aggregate                  `json:"aggregates,omitempty"`
+	Session    *Session                      `firestore:"session" json:"session,omitempty"`
+	Snapshot   *CharacterSnapshot            `firestore:"characterSnapshot" json:"snapshot,omitempty"`
+	Snapshots  *map[string]CharacterSnapshot `json:"snapshots,omitempty"`
+
+	// Type Kind of resource a share link points to
+	Type ShareType `firestore:"type" json:"type"`
+}
+
+func (response GetSharedContent200JSONResponse) VisitGetSharedContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedContent400JSONResponse OneTrickError
+
+func (response GetSharedContent400JSONResponse) VisitGetSharedContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedContent401JSONResponse OneTrickError
+
+func (response GetSharedContent401JSONResponse) VisitGetSharedContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedContent404JSONResponse OneTrickError
+
+func (response GetSharedContent404JSONResponse) VisitGetSharedContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSharedContent500JSONResponse OneTrickError
+
+func (response GetSharedContent500JSONResponse) VisitGetSharedContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokenRequestObject struct {
+	Body *RefreshTokenJSONRequestBody
+}
+
+type RefreshTokenResponseObject interface {
+	VisitRefreshTokenResponse(w http.ResponseWriter) error
+}
+
+type RefreshToken200JSONResponse AuthResponse
+
+func (response RefreshToken200JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchRequestObject struct {
+	Body *SearchJSONRequestBody
+}
+
+type SearchResponseObject interface {
+	VisitSearchResponse(w http.ResponseWriter) error
+}
+
+type Search200JSONResponse struct {
+	HasMore bool               `json:"hasMore"`
+	Results []SearchUserResult `json:"results"`
+}
+
+func (response Search200JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionsRequestObject struct {
+	Params GetSessionsParams
+}
+
+type GetSessionsResponseObject interface {
+	VisitGetSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetSessions200JSONResponse []Session
+
+func (response GetSessions200JSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartSessionRequestObject struct {
+	Params StartSessionParams
+	Body   *StartSessionJSONRequestBody
+}
+
+type StartSessionResponseObject interface {
+	VisitStartSessionResponse(w http.ResponseWriter) error
+}
+
+type StartSession201JSONResponse Session
+
+func (response StartSession201JSONResponse) VisitStartSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartSession400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response StartSession400JSONResponse) VisitStartSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionRequestObject struct {
+	SessionId string `json:"sessionId"`
+}
+
+type GetSessionResponseObject interface {
+	VisitGetSessionResponse(w http.ResponseWriter) error
+}
+
+type GetSession200JSONResponse Session
+
+func (response GetSession200JSONResponse) VisitGetSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSessionRequestObject struct {
+	SessionID string `json:"sessionId"`
+	Params    UpdateSessionParams
+	Body      *UpdateSessionJSONRequestBody
+}
+
+type UpdateSessionResponseObject interface {
+	VisitUpdateSessionResponse(w http.ResponseWriter) error
+}
+
+type UpdateSession200JSONResponse Session
+
+func (response UpdateSession200JSONResponse) VisitUpdateSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionAggregatesRequestObject struct {
+	SessionId string `json:"sessionId"`
+	Params    GetSessionAggregatesParams
+}
+
+type GetSessionAggregatesResponseObject interface {
+	VisitGetSessionAggregatesResponse(w http.ResponseWriter) error
+}
+
+type GetSessionAggregates200JSONResponse struct {
+	// Abilities Kills made with abilities rather than weapons. Shares are out of every kill, so totalShare is how much of a player's damage output comes from their subclass build.
+	Abilities  *AbilityKills                `json:"abilities,omitempty"`
+	Aggregates []Aggregate                  `json:"aggregates"`
+	Snapshots  map[string]CharacterSnapshot `json:"snapshots"`
+
+	// Streaks Win and loss streaks over a set of matches, along with the player's tilt status.
+	Streaks *StreakSummary `json:"streaks,omitempty"`
+}
+
+func (response GetSessionAggregates200JSONResponse) VisitGetSessionAggregatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CompleteSessionRequestObject struct {
+	SessionId string `json:"sessionId"`
+	Params    CompleteSessionParams
+	Body      *CompleteSessionJSONRequestBody
+}
+
+type CompleteSessionResponseObject interface {
+	VisitCompleteSessionResponse(w http.ResponseWriter) error
+}
+
+type CompleteSession200JSONResponse Session
+
+func (response CompleteSession200JSONResponse) VisitCompleteSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetShareLinksRequestObject struct {
+	Params GetShareLinksParams
+}
+
+type GetShareLinksResponseObject interface {
+	VisitGetShareLinksResponse(w http.ResponseWriter) error
+}
+
+type GetShareLinks200JSONResponse []ShareLink
+
+func (response GetShareLinks200JSONResponse) VisitGetShareLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLinkRequestObject struct {
+	Params CreateShareLinkParams
+	Body   *CreateShareLinkJSONRequestBody
+}
+
+type CreateShareLinkResponseObject interface {
+	VisitCreateShareLinkResponse(w http.ResponseWriter) error
+}
+
+type CreateShareLink201JSONResponse ShareLink
+
+func (response CreateShareLink201JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink400JSONResponse OneTrickError
+
+func (response CreateShareLink400JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink401JSONResponse OneTrickError
+
+func (response CreateShareLink401JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink404JSONResponse OneTrickError
+
+func (response CreateShareLink404JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateShareLink500JSONResponse OneTrickError
+
+func (response CreateShareLink500JSONResponse) VisitCreateShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLinkRequestObject struct {
+	Token  string `json:"token"`
+	Params RevokeShareLinkParams
+}
+
+type RevokeShareLinkResponseObject interface {
+	VisitRevokeShareLinkResponse(w http.ResponseWriter) error
+}
+
+type RevokeShareLink200JSONResponse ShareLink
+
+func (response RevokeShareLink200JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLink400JSONResponse OneTrickError
+
+func (response RevokeShareLink400JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLink401JSONResponse OneTrickError
+
+func (response RevokeShareLink401JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLink404JSONResponse OneTrickError
+
+func (response RevokeShareLink404JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeShareLink500JSONResponse OneTrickError
+
+func (response RevokeShareLink500JSONResponse) VisitRevokeShareLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSnapshotsRequestObject struct {
+	Params GetSnapshotsParams
+}
+
+type GetSnapshotsResponseObject interface {
+	VisitGetSnapshotsResponse(w http.ResponseWriter) error
+}
+
+type GetSnapshots200JSONResponse []CharacterSnapshot
+
+func (response GetSnapshots200JSONResponse) VisitGetSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSnapshotRequestObject struct {
+	Params CreateSnapshotParams
+	Body   *CreateSnapshotJSONRequestBody
+}
+
+type CreateSnapshotResponseObject interface {
+	VisitCreateSnapshotResponse(w http.ResponseWriter) error
+}
+
+type CreateSnapshot201JSONResponse CharacterSnapshot
+
+func (response CreateSnapshot201JSONResponse) VisitCreateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSnapshotRequestObject struct {
+	SnapshotID string `json:"snapshotId"`
+}
+
+type GetSnapshotResponseObject interface {
+	VisitGetSnapshotResponse(w http.ResponseWriter) error
+}
+
+type GetSnapshot200JSONResponse CharacterSnapshot
+
+func (response GetSnapshot200JSONResponse) VisitGetSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSnapshotRequestObject struct {
+	SnapshotID string `json:"snapshotId"`
+	Params     UpdateSnapshotParams
+	Body       *UpdateSnapshotJSONRequestBody
+}
+
+type UpdateSnapshotResponseObject interface {
+	VisitUpdateSnapshotResponse(w http.ResponseWriter) error
+}
+
+type UpdateSnapshot200JSONResponse CharacterSnapshot
+
+func (response UpdateSnapshot200JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSnapshot400JSONResponse OneTrickError
+
+func (response UpdateSnapshot400JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSnapshot401JSONResponse OneTrickError
+
+func (response UpdateSnapshot401JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSnapshot404JSONResponse OneTrickError
+
+func (response UpdateSnapshot404JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSnapshot500JSONResponse OneTrickError
+
+func (response UpdateSnapshot500JSONResponse) VisitUpdateSnapshotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSnapshotAggregatesRequestObject struct {
+	SnapshotID string `json:"snapshotId"`
+	Params     GetSnapshotAggregatesParams
+}
+
+type GetSnapshotAggregatesResponseObject interface {
+	VisitGetSnapshotAggregatesResponse(w http.ResponseWriter) error
+}
+
+type GetSnapshotAggregates200JSONResponse []Aggregate
+
+func (response GetSnapshotAggregates200JSONResponse) VisitGetSnapshotAggregatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeSnapshotsRequestObject struct {
+	SnapshotID string `json:"snapshotId"`
+	Params     MergeSnapshotsParams
+	Body       *MergeSnapshotsJSONRequestBody
+}
+
+type MergeSnapshotsResponseObject interface {
+	VisitMergeSnapshotsResponse(w http.ResponseWriter) error
+}
+
+type MergeSnapshots200JSONResponse bool
+
+func (response MergeSnapshots200JSONResponse) VisitMergeSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MergeSnapshots500JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response MergeSnapshots500JSONResponse) VisitMergeSnapshotsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserRequestObject struct {
+	UserID string `json:"userId"`
+}
+
+type GetUserResponseObject interface {
+	VisitGetUserResponse(w http.ResponseWriter) error
+}
+
+type GetUser200JSONResponse Profile
+
+func (response GetUser200JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUser503JSONResponse struct {
+	// Message User friendly description of the error
+	Message string        `json:"message"`
+	Status  InternalError `json:"status"`
+}
+
+func (response GetUser503JSONResponse) VisitGetUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(503)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserDigestsRequestObject struct {
+	UserID string `json:"userId"`
+	Params GetUserDigestsParams
+}
+
+type GetUserDigestsResponseObject interface {
+	VisitGetUserDigestsResponse(w http.ResponseWriter) error
+}
+
+type GetUserDigests200JSONResponse []Digest
+
+func (response GetUserDigests200JSONResponse) VisitGetUserDigestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserDigests401JSONResponse OneTrickError
+
+func (response GetUserDigests401JSONResponse) VisitGetUserDigestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserDigests500JSONResponse OneTrickError
+
+func (response GetUserDigests500JSONResponse) VisitGetUserDigestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserSessionsRequestObject struct {
+	UserID string `json:"userId"`
+	Params GetUserSessionsParams
+}
+
+type GetUserSessionsResponseObject interface {
+	VisitGetUserSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetUserSessions200JSONResponse []Session
+
+func (response GetUserSessions200JSONResponse) VisitGetUserSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type StartUserSessionRequestObject struct {
@@ -2659,135 +6723,924 @@ type StartUserSessionRequestObject struct {
 	Body   *StartUserSessionJSONRequestBody
 }
 
-type StartUserSessionResponseObject interface {
-	VisitStartUserSessionResponse(w http.ResponseWriter) error
+type StartUserSessionResponseObject interface {
+	VisitStartUserSessionResponse(w http.ResponseWriter) error
+}
+
+type StartUserSession201JSONResponse Session
+
+func (response StartUserSession201JSONResponse) VisitStartUserSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StartUserSession400JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response StartUserSession400JSONResponse) VisitStartUserSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+
+	// (GET /activities)
+	GetActivities(ctx context.Context, request GetActivitiesRequestObject) (GetActivitiesResponseObject, error)
+
+	// (GET /activities/{activityId})
+	GetActivity(ctx context.Context, request GetActivityRequestObject) (GetActivityResponseObject, error)
+
+	// (POST /admin/backfill-aggregate-data)
+	BackfillAggregateData(ctx context.Context, request BackfillAggregateDataRequestObject) (BackfillAggregateDataResponseObject, error)
+
+	// (POST /admin/backfill-character-ids)
+	BackfillAllUsersCharacterIds(ctx context.Context, request BackfillAllUsersCharacterIdsRequestObject) (BackfillAllUsersCharacterIdsResponseObject, error)
+
+	// (POST /admin/backfill-lobby-strength)
+	BackfillLobbyStrength(ctx context.Context, request BackfillLobbyStrengthRequestObject) (BackfillLobbyStrengthResponseObject, error)
+
+	// (POST /admin/backfill-snapshot-base-info)
+	BackfillSnapshotInfo(ctx context.Context, request BackfillSnapshotInfoRequestObject) (BackfillSnapshotInfoResponseObject, error)
+
+	// (POST /admin/generate-digests)
+	GenerateDigests(ctx context.Context, request GenerateDigestsRequestObject) (GenerateDigestsResponseObject, error)
+
+	// (POST /admin/generate-meta-reports)
+	GenerateMetaReports(ctx context.Context, request GenerateMetaReportsRequestObject) (GenerateMetaReportsResponseObject, error)
+
+	// (POST /admin/rebuild-rollups)
+	RebuildRollups(ctx context.Context, request RebuildRollupsRequestObject) (RebuildRollupsResponseObject, error)
+
+	// (GET /fireteam)
+	GetFireteam(ctx context.Context, request GetFireteamRequestObject) (GetFireteamResponseObject, error)
+
+	// (GET /groups)
+	GetGroups(ctx context.Context, request GetGroupsRequestObject) (GetGroupsResponseObject, error)
+
+	// (POST /groups)
+	CreateGroup(ctx context.Context, request CreateGroupRequestObject) (CreateGroupResponseObject, error)
+
+	// (DELETE /groups/{groupId})
+	DeleteGroup(ctx context.Context, request DeleteGroupRequestObject) (DeleteGroupResponseObject, error)
+
+	// (PUT /groups/{groupId})
+	UpdateGroup(ctx context.Context, request UpdateGroupRequestObject) (UpdateGroupResponseObject, error)
+
+	// (POST /groups/{groupId}/invitation)
+	RespondToGroupInvitation(ctx context.Context, request RespondToGroupInvitationRequestObject) (RespondToGroupInvitationResponseObject, error)
+	// Ranks the user's friends, fireteam or group over a time window
+	// (GET /leaderboards)
+	GetLeaderboard(ctx context.Context, request GetLeaderboardRequestObject) (GetLeaderboardResponseObject, error)
+
+	// (POST /login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Ability kill share for a character and each of its snapshots
+	// (GET /metrics/abilities)
+	GetAbilityKills(ctx context.Context, request GetAbilityKillsRequestObject) (GetAbilityKillsResponseObject, error)
+
+	// (GET /metrics/best-performing-loadouts)
+	GetBestPerformingLoadouts(ctx context.Context, request GetBestPerformingLoadoutsRequestObject) (GetBestPerformingLoadoutsResponseObject, error)
+	// Correlates the class stats of a character's snapshots with performance
+	// (GET /metrics/class-stats)
+	GetClassStatAnalysis(ctx context.Context, request GetClassStatAnalysisRequestObject) (GetClassStatAnalysisResponseObject, error)
+	// Compare two loadouts head to head
+	// (GET /metrics/compare)
+	CompareLoadouts(ctx context.Context, request CompareLoadoutsRequestObject) (CompareLoadoutsResponseObject, error)
+
+	// (GET /metrics/maps)
+	GetMapPerformance(ctx context.Context, request GetMapPerformanceRequestObject) (GetMapPerformanceResponseObject, error)
+	// Community meta across every OneTrick player
+	// (GET /metrics/meta)
+	GetMetaReport(ctx context.Context, request GetMetaReportRequestObject) (GetMetaReportResponseObject, error)
+
+	// (GET /metrics/most-used-loadouts)
+	GetMostUsedLoadouts(ctx context.Context, request GetMostUsedLoadoutsRequestObject) (GetMostUsedLoadoutsResponseObject, error)
+
+	// (GET /metrics/rollups)
+	GetRollups(ctx context.Context, request GetRollupsRequestObject) (GetRollupsResponseObject, error)
+	// Performance by time of day and day of the week
+	// (GET /metrics/schedule)
+	GetSchedule(ctx context.Context, request GetScheduleRequestObject) (GetScheduleResponseObject, error)
+	// Win and loss streaks and tilt status for a character
+	// (GET /metrics/streaks)
+	GetStreaks(ctx context.Context, request GetStreaksRequestObject) (GetStreaksResponseObject, error)
+
+	// (GET /metrics/teammates)
+	GetTeammates(ctx context.Context, request GetTeammatesRequestObject) (GetTeammatesResponseObject, error)
+	// Performance over time for a character
+	// (GET /metrics/trend)
+	GetTrend(ctx context.Context, request GetTrendRequestObject) (GetTrendResponseObject, error)
+	// Trials of Osiris cards for a character
+	// (GET /metrics/trials-cards)
+	GetTrialsCards(ctx context.Context, request GetTrialsCardsRequestObject) (GetTrialsCardsResponseObject, error)
+	// Weapon performance grouped by archetype, damage type and tier
+	// (GET /metrics/weapon-types)
+	GetWeaponTypePerformance(ctx context.Context, request GetWeaponTypePerformanceRequestObject) (GetWeaponTypePerformanceResponseObject, error)
+
+	// (GET /metrics/weapons)
+	GetWeaponPerformance(ctx context.Context, request GetWeaponPerformanceRequestObject) (GetWeaponPerformanceResponseObject, error)
+	// Compare the rolls of a weapon
+	// (GET /metrics/weapons/{weaponHash}/perks)
+	GetPerkPerformance(ctx context.Context, request GetPerkPerformanceRequestObject) (GetPerkPerformanceResponseObject, error)
+
+	// (GET /ping)
+	GetPing(ctx context.Context, request GetPingRequestObject) (GetPingResponseObject, error)
+
+	// (GET /public/shares/{token})
+	GetSharedContent(ctx context.Context, request GetSharedContentRequestObject) (GetSharedContentResponseObject, error)
+
+	// (POST /refresh)
+	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
+
+	// (POST /search)
+	Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error)
+
+	// (GET /sessions)
+	GetSessions(ctx context.Context, request GetSessionsRequestObject) (GetSessionsResponseObject, error)
+
+	// (POST /sessions)
+	StartSession(ctx context.Context, request StartSessionRequestObject) (StartSessionResponseObject, error)
+
+	// (GET /sessions/{sessionId})
+	GetSession(ctx context.Context, request GetSessionRequestObject) (GetSessionResponseObject, error)
+
+	// (PUT /sessions/{sessionId})
+	UpdateSession(ctx context.Context, request UpdateSessionRequestObject) (UpdateSessionResponseObject, error)
+
+	// (GET /sessions/{sessionId}/aggregates)
+	GetSessionAggregates(ctx context.Context, request GetSessionAggregatesRequestObject) (GetSessionAggregatesResponseObject, error)
+
+	// (PUT /sessions/{sessionId}/complete)
+	CompleteSession(ctx context.Context, request CompleteSessionRequestObject) (CompleteSessionResponseObject, error)
+
+	// (GET /shares)
+	GetShareLinks(ctx context.Context, request GetShareLinksRequestObject) (GetShareLinksResponseObject, error)
+
+	// (POST /shares)
+	CreateShareLink(ctx context.Context, request CreateShareLinkRequestObject) (CreateShareLinkResponseObject, error)
+
+	// (DELETE /shares/{token})
+	RevokeShareLink(ctx context.Context, request RevokeShareLinkRequestObject) (RevokeShareLinkResponseObject, error)
+
+	// (GET /snapshots)
+	GetSnapshots(ctx context.Context, request GetSnapshotsRequestObject) (GetSnapshotsResponseObject, error)
+
+	// (POST /snapshots)
+	CreateSnapshot(ctx context.Context, request CreateSnapshotRequestObject) (CreateSnapshotResponseObject, error)
+
+	// (GET /snapshots/{snapshotId})
+	GetSnapshot(ctx context.Context, request GetSnapshotRequestObject) (GetSnapshotResponseObject, error)
+
+	// (PUT /snapshots/{snapshotId})
+	UpdateSnapshot(ctx context.Context, request UpdateSnapshotRequestObject) (UpdateSnapshotResponseObject, error)
+
+	// (GET /snapshots/{snapshotId}/aggregates)
+	GetSnapshotAggregates(ctx context.Context, request GetSnapshotAggregatesRequestObject) (GetSnapshotAggregatesResponseObject, error)
+
+	// (POST /snapshots/{snapshotId}/merge)
+	MergeSnapshots(ctx context.Context, request MergeSnapshotsRequestObject) (MergeSnapshotsResponseObject, error)
+
+	// (GET /users/{userId})
+	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
+	// Weekly digests of a user
+	// (GET /users/{userId}/digests)
+	GetUserDigests(ctx context.Context, request GetUserDigestsRequestObject) (GetUserDigestsResponseObject, error)
+
+	// (GET /users/{userId}/sessions)
+	GetUserSessions(ctx context.Context, request GetUserSessionsRequestObject) (GetUserSessionsResponseObject, error)
+
+	// (POST /users/{userId}/sessions)
+	StartUserSession(ctx context.Context, request StartUserSessionRequestObject) (StartUserSessionResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetActivities operation middleware
+func (sh *strictHandler) GetActivities(ctx *gin.Context, params GetActivitiesParams) {
+	var request GetActivitiesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetActivities(ctx, request.(GetActivitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetActivities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetActivitiesResponseObject); ok {
+		if err := validResponse.VisitGetActivitiesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetActivity operation middleware
+func (sh *strictHandler) GetActivity(ctx *gin.Context, activityID string, params GetActivityParams) {
+	var request GetActivityRequestObject
+
+	request.ActivityID = activityID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetActivity(ctx, request.(GetActivityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetActivity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetActivityResponseObject); ok {
+		if err := validResponse.VisitGetActivityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BackfillAggregateData operation middleware
+func (sh *strictHandler) BackfillAggregateData(ctx *gin.Context) {
+	var request BackfillAggregateDataRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BackfillAggregateData(ctx, request.(BackfillAggregateDataRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BackfillAggregateData")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BackfillAggregateDataResponseObject); ok {
+		if err := validResponse.VisitBackfillAggregateDataResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BackfillAllUsersCharacterIds operation middleware
+func (sh *strictHandler) BackfillAllUsersCharacterIds(ctx *gin.Context) {
+	var request BackfillAllUsersCharacterIdsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BackfillAllUsersCharacterIds(ctx, request.(BackfillAllUsersCharacterIdsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BackfillAllUsersCharacterIds")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BackfillAllUsersCharacterIdsResponseObject); ok {
+		if err := validResponse.VisitBackfillAllUsersCharacterIdsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BackfillLobbyStrength operation middleware
+func (sh *strictHandler) BackfillLobbyStrength(ctx *gin.Context) {
+	var request BackfillLobbyStrengthRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BackfillLobbyStrength(ctx, request.(BackfillLobbyStrengthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BackfillLobbyStrength")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BackfillLobbyStrengthResponseObject); ok {
+		if err := validResponse.VisitBackfillLobbyStrengthResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// BackfillSnapshotInfo operation middleware
+func (sh *strictHandler) BackfillSnapshotInfo(ctx *gin.Context) {
+	var request BackfillSnapshotInfoRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.BackfillSnapshotInfo(ctx, request.(BackfillSnapshotInfoRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "BackfillSnapshotInfo")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(BackfillSnapshotInfoResponseObject); ok {
+		if err := validResponse.VisitBackfillSnapshotInfoResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GenerateDigests operation middleware
+func (sh *strictHandler) GenerateDigests(ctx *gin.Context, params GenerateDigestsParams) {
+	var request GenerateDigestsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GenerateDigests(ctx, request.(GenerateDigestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GenerateDigests")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GenerateDigestsResponseObject); ok {
+		if err := validResponse.VisitGenerateDigestsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GenerateMetaReports operation middleware
+func (sh *strictHandler) GenerateMetaReports(ctx *gin.Context) {
+	var request GenerateMetaReportsRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GenerateMetaReports(ctx, request.(GenerateMetaReportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GenerateMetaReports")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GenerateMetaReportsResponseObject); ok {
+		if err := validResponse.VisitGenerateMetaReportsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RebuildRollups operation middleware
+func (sh *strictHandler) RebuildRollups(ctx *gin.Context, params RebuildRollupsParams) {
+	var request RebuildRollupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RebuildRollups(ctx, request.(RebuildRollupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RebuildRollups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RebuildRollupsResponseObject); ok {
+		if err := validResponse.VisitRebuildRollupsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetFireteam operation middleware
+func (sh *strictHandler) GetFireteam(ctx *gin.Context, params GetFireteamParams) {
+	var request GetFireteamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFireteam(ctx, request.(GetFireteamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFireteam")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetFireteamResponseObject); ok {
+		if err := validResponse.VisitGetFireteamResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetGroups operation middleware
+func (sh *strictHandler) GetGroups(ctx *gin.Context, params GetGroupsParams) {
+	var request GetGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetGroups(ctx, request.(GetGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetGroups")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetGroupsResponseObject); ok {
+		if err := validResponse.VisitGetGroupsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateGroup operation middleware
+func (sh *strictHandler) CreateGroup(ctx *gin.Context, params CreateGroupParams) {
+	var request CreateGroupRequestObject
+
+	request.Params = params
+
+	var body CreateGroupJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateGroup(ctx, request.(CreateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateGroupResponseObject); ok {
+		if err := validResponse.VisitCreateGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteGroup operation middleware
+func (sh *strictHandler) DeleteGroup(ctx *gin.Context, groupID string, params DeleteGroupParams) {
+	var request DeleteGroupRequestObject
+
+	request.GroupID = groupID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteGroup(ctx, request.(DeleteGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StartUserSession201JSONResponse Session
+// UpdateGroup operation middleware
+func (sh *strictHandler) UpdateGroup(ctx *gin.Context, groupID string, params UpdateGroupParams) {
+	var request UpdateGroupRequestObject
 
-func (response StartUserSession201JSONResponse) VisitStartUserSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.GroupID = groupID
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	var body UpdateGroupJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateGroup(ctx, request.(UpdateGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateGroup")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateGroupResponseObject); ok {
+		if err := validResponse.VisitUpdateGroupResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StartUserSession400JSONResponse struct {
-	Message string `json:"message"`
+// RespondToGroupInvitation operation middleware
+func (sh *strictHandler) RespondToGroupInvitation(ctx *gin.Context, groupID string, params RespondToGroupInvitationParams) {
+	var request RespondToGroupInvitationRequestObject
+
+	request.GroupID = groupID
+	request.Params = params
+
+	var body RespondToGroupInvitationJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RespondToGroupInvitation(ctx, request.(RespondToGroupInvitationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RespondToGroupInvitation")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RespondToGroupInvitationResponseObject); ok {
+		if err := validResponse.VisitRespondToGroupInvitationResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func (response StartUserSession400JSONResponse) VisitStartUserSessionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetLeaderboard operation middleware
+func (sh *strictHandler) GetLeaderboard(ctx *gin.Context, params GetLeaderboardParams) {
+	var request GetLeaderboardRequestObject
 
-	return json.NewEncoder(w).Encode(response)
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLeaderboard(ctx, request.(GetLeaderboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLeaderboard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetLeaderboardResponseObject); ok {
+		if err := validResponse.VisitGetLeaderboardResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+// Login operation middleware
+func (sh *strictHandler) Login(ctx *gin.Context) {
+	var request LoginRequestObject
 
-	// (GET /activities)
-	GetActivities(ctx context.Context, request GetActivitiesRequestObject) (GetActivitiesResponseObject, error)
+	var body LoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	// (GET /activities/{activityId})
-	GetActivity(ctx context.Context, request GetActivityRequestObject) (GetActivityResponseObject, error)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
 
-	// (POST /admin/backfill-aggregate-data)
-	BackfillAggregateData(ctx context.Context, request BackfillAggregateDataRequestObject) (BackfillAggregateDataResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /admin/backfill-character-ids)
-	BackfillAllUsersCharacterIds(ctx context.Context, request BackfillAllUsersCharacterIdsRequestObject) (BackfillAllUsersCharacterIdsResponseObject, error)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /admin/backfill-snapshot-base-info)
-	BackfillSnapshotInfo(ctx context.Context, request BackfillSnapshotInfoRequestObject) (BackfillSnapshotInfoResponseObject, error)
+// GetAbilityKills operation middleware
+func (sh *strictHandler) GetAbilityKills(ctx *gin.Context, params GetAbilityKillsParams) {
+	var request GetAbilityKillsRequestObject
 
-	// (GET /fireteam)
-	GetFireteam(ctx context.Context, request GetFireteamRequestObject) (GetFireteamResponseObject, error)
+	request.Params = params
 
-	// (POST /login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAbilityKills(ctx, request.(GetAbilityKillsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAbilityKills")
+	}
 
-	// (GET /metrics/best-performing-loadouts)
-	GetBestPerformingLoadouts(ctx context.Context, request GetBestPerformingLoadoutsRequestObject) (GetBestPerformingLoadoutsResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /ping)
-	GetPing(ctx context.Context, request GetPingRequestObject) (GetPingResponseObject, error)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAbilityKillsResponseObject); ok {
+		if err := validResponse.VisitGetAbilityKillsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (POST /refresh)
-	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
+// GetBestPerformingLoadouts operation middleware
+func (sh *strictHandler) GetBestPerformingLoadouts(ctx *gin.Context, params GetBestPerformingLoadoutsParams) {
+	var request GetBestPerformingLoadoutsRequestObject
 
-	// (POST /search)
-	Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error)
+	request.Params = params
 
-	// (GET /sessions)
-	GetSessions(ctx context.Context, request GetSessionsRequestObject) (GetSessionsResponseObject, error)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBestPerformingLoadouts(ctx, request.(GetBestPerformingLoadoutsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBestPerformingLoadouts")
+	}
 
-	// (POST /sessions)
-	StartSession(ctx context.Context, request StartSessionRequestObject) (StartSessionResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /sessions/{sessionId})
-	GetSession(ctx context.Context, request GetSessionRequestObject) (GetSessionResponseObject, error)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetBestPerformingLoadoutsResponseObject); ok {
+		if err := validResponse.VisitGetBestPerformingLoadoutsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetClassStatAnalysis operation middleware
+func (sh *strictHandler) GetClassStatAnalysis(ctx *gin.Context, params GetClassStatAnalysisParams) {
+	var request GetClassStatAnalysisRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetClassStatAnalysis(ctx, request.(GetClassStatAnalysisRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetClassStatAnalysis")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetClassStatAnalysisResponseObject); ok {
+		if err := validResponse.VisitGetClassStatAnalysisResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CompareLoadouts operation middleware
+func (sh *strictHandler) CompareLoadouts(ctx *gin.Context, params CompareLoadoutsParams) {
+	var request CompareLoadoutsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CompareLoadouts(ctx, request.(CompareLoadoutsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CompareLoadouts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CompareLoadoutsResponseObject); ok {
+		if err := validResponse.VisitCompareLoadoutsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMapPerformance operation middleware
+func (sh *strictHandler) GetMapPerformance(ctx *gin.Context, params GetMapPerformanceParams) {
+	var request GetMapPerformanceRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMapPerformance(ctx, request.(GetMapPerformanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMapPerformance")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMapPerformanceResponseObject); ok {
+		if err := validResponse.VisitGetMapPerformanceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (PUT /sessions/{sessionId})
-	UpdateSession(ctx context.Context, request UpdateSessionRequestObject) (UpdateSessionResponseObject, error)
+// GetMetaReport operation middleware
+func (sh *strictHandler) GetMetaReport(ctx *gin.Context, params GetMetaReportParams) {
+	var request GetMetaReportRequestObject
 
-	// (GET /sessions/{sessionId}/aggregates)
-	GetSessionAggregates(ctx context.Context, request GetSessionAggregatesRequestObject) (GetSessionAggregatesResponseObject, error)
+	request.Params = params
 
-	// (PUT /sessions/{sessionId}/complete)
-	CompleteSession(ctx context.Context, request CompleteSessionRequestObject) (CompleteSessionResponseObject, error)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMetaReport(ctx, request.(GetMetaReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMetaReport")
+	}
 
-	// (GET /snapshots)
-	GetSnapshots(ctx context.Context, request GetSnapshotsRequestObject) (GetSnapshotsResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (POST /snapshots)
-	CreateSnapshot(ctx context.Context, request CreateSnapshotRequestObject) (CreateSnapshotResponseObject, error)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMetaReportResponseObject); ok {
+		if err := validResponse.VisitGetMetaReportResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /snapshots/{snapshotId})
-	GetSnapshot(ctx context.Context, request GetSnapshotRequestObject) (GetSnapshotResponseObject, error)
+// GetMostUsedLoadouts operation middleware
+func (sh *strictHandler) GetMostUsedLoadouts(ctx *gin.Context, params GetMostUsedLoadoutsParams) {
+	var request GetMostUsedLoadoutsRequestObject
 
-	// (PUT /snapshots/{snapshotId})
-	UpdateSnapshot(ctx context.Context, request UpdateSnapshotRequestObject) (UpdateSnapshotResponseObject, error)
+	request.Params = params
 
-	// (GET /snapshots/{snapshotId}/aggregates)
-	GetSnapshotAggregates(ctx context.Context, request GetSnapshotAggregatesRequestObject) (GetSnapshotAggregatesResponseObject, error)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMostUsedLoadouts(ctx, request.(GetMostUsedLoadoutsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMostUsedLoadouts")
+	}
 
-	// (POST /snapshots/{snapshotId}/merge)
-	MergeSnapshots(ctx context.Context, request MergeSnapshotsRequestObject) (MergeSnapshotsResponseObject, error)
+	response, err := handler(ctx, request)
 
-	// (GET /users/{userId})
-	GetUser(ctx context.Context, request GetUserRequestObject) (GetUserResponseObject, error)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetMostUsedLoadoutsResponseObject); ok {
+		if err := validResponse.VisitGetMostUsedLoadoutsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	// (GET /users/{userId}/sessions)
-	GetUserSessions(ctx context.Context, request GetUserSessionsRequestObject) (GetUserSessionsResponseObject, error)
+// GetRollups operation middleware
+func (sh *strictHandler) GetRollups(ctx *gin.Context, params GetRollupsParams) {
+	var request GetRollupsRequestObject
 
-	// (POST /users/{userId}/sessions)
-	StartUserSession(ctx context.Context, request StartUserSessionRequestObject) (StartUserSessionResponseObject, error)
-}
+	request.Params = params
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRollups(ctx, request.(GetRollupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRollups")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetRollupsResponseObject); ok {
+		if err := validResponse.VisitGetRollupsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetActivities operation middleware
-func (sh *strictHandler) GetActivities(ctx *gin.Context, params GetActivitiesParams) {
-	var request GetActivitiesRequestObject
+// GetSchedule operation middleware
+func (sh *strictHandler) GetSchedule(ctx *gin.Context, params GetScheduleParams) {
+	var request GetScheduleRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetActivities(ctx, request.(GetActivitiesRequestObject))
+		return sh.ssi.GetSchedule(ctx, request.(GetScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetActivities")
+		handler = middleware(handler, "GetSchedule")
 	}
 
 	response, err := handler(ctx, request)
@@ -2795,8 +7648,8 @@ func (sh *strictHandler) GetActivities(ctx *gin.Context, params GetActivitiesPar
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetActivitiesResponseObject); ok {
-		if err := validResponse.VisitGetActivitiesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetScheduleResponseObject); ok {
+		if err := validResponse.VisitGetScheduleResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2804,18 +7657,17 @@ func (sh *strictHandler) GetActivities(ctx *gin.Context, params GetActivitiesPar
 	}
 }
 
-// GetActivity operation middleware
-func (sh *strictHandler) GetActivity(ctx *gin.Context, activityID string, params GetActivityParams) {
-	var request GetActivityRequestObject
+// GetStreaks operation middleware
+func (sh *strictHandler) GetStreaks(ctx *gin.Context, params GetStreaksParams) {
+	var request GetStreaksRequestObject
 
-	request.ActivityID = activityID
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetActivity(ctx, request.(GetActivityRequestObject))
+		return sh.ssi.GetStreaks(ctx, request.(GetStreaksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetActivity")
+		handler = middleware(handler, "GetStreaks")
 	}
 
 	response, err := handler(ctx, request)
@@ -2823,8 +7675,8 @@ func (sh *strictHandler) GetActivity(ctx *gin.Context, activityID string, params
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetActivityResponseObject); ok {
-		if err := validResponse.VisitGetActivityResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetStreaksResponseObject); ok {
+		if err := validResponse.VisitGetStreaksResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2832,15 +7684,17 @@ func (sh *strictHandler) GetActivity(ctx *gin.Context, activityID string, params
 	}
 }
 
-// BackfillAggregateData operation middleware
-func (sh *strictHandler) BackfillAggregateData(ctx *gin.Context) {
-	var request BackfillAggregateDataRequestObject
+// GetTeammates operation middleware
+func (sh *strictHandler) GetTeammates(ctx *gin.Context, params GetTeammatesParams) {
+	var request GetTeammatesRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.BackfillAggregateData(ctx, request.(BackfillAggregateDataRequestObject))
+		return sh.ssi.GetTeammates(ctx, request.(GetTeammatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BackfillAggregateData")
+		handler = middleware(handler, "GetTeammates")
 	}
 
 	response, err := handler(ctx, request)
@@ -2848,8 +7702,8 @@ func (sh *strictHandler) BackfillAggregateData(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(BackfillAggregateDataResponseObject); ok {
-		if err := validResponse.VisitBackfillAggregateDataResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetTeammatesResponseObject); ok {
+		if err := validResponse.VisitGetTeammatesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2857,15 +7711,17 @@ func (sh *strictHandler) BackfillAggregateData(ctx *gin.Context) {
 	}
 }
 
-// BackfillAllUsersCharacterIds operation middleware
-func (sh *strictHandler) BackfillAllUsersCharacterIds(ctx *gin.Context) {
-	var request BackfillAllUsersCharacterIdsRequestObject
+// GetTrend operation middleware
+func (sh *strictHandler) GetTrend(ctx *gin.Context, params GetTrendParams) {
+	var request GetTrendRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.BackfillAllUsersCharacterIds(ctx, request.(BackfillAllUsersCharacterIdsRequestObject))
+		return sh.ssi.GetTrend(ctx, request.(GetTrendRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BackfillAllUsersCharacterIds")
+		handler = middleware(handler, "GetTrend")
 	}
 
 	response, err := handler(ctx, request)
@@ -2873,8 +7729,8 @@ func (sh *strictHandler) BackfillAllUsersCharacterIds(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(BackfillAllUsersCharacterIdsResponseObject); ok {
-		if err := validResponse.VisitBackfillAllUsersCharacterIdsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetTrendResponseObject); ok {
+		if err := validResponse.VisitGetTrendResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2882,15 +7738,17 @@ func (sh *strictHandler) BackfillAllUsersCharacterIds(ctx *gin.Context) {
 	}
 }
 
-// BackfillSnapshotInfo operation middleware
-func (sh *strictHandler) BackfillSnapshotInfo(ctx *gin.Context) {
-	var request BackfillSnapshotInfoRequestObject
+// GetTrialsCards operation middleware
+func (sh *strictHandler) GetTrialsCards(ctx *gin.Context, params GetTrialsCardsParams) {
+	var request GetTrialsCardsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.BackfillSnapshotInfo(ctx, request.(BackfillSnapshotInfoRequestObject))
+		return sh.ssi.GetTrialsCards(ctx, request.(GetTrialsCardsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "BackfillSnapshotInfo")
+		handler = middleware(handler, "GetTrialsCards")
 	}
 
 	response, err := handler(ctx, request)
@@ -2898,8 +7756,8 @@ func (sh *strictHandler) BackfillSnapshotInfo(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(BackfillSnapshotInfoResponseObject); ok {
-		if err := validResponse.VisitBackfillSnapshotInfoResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetTrialsCardsResponseObject); ok {
+		if err := validResponse.VisitGetTrialsCardsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2907,17 +7765,17 @@ func (sh *strictHandler) BackfillSnapshotInfo(ctx *gin.Context) {
 	}
 }
 
-// GetFireteam operation middleware
-func (sh *strictHandler) GetFireteam(ctx *gin.Context, params GetFireteamParams) {
-	var request GetFireteamRequestObject
+// GetWeaponTypePerformance operation middleware
+func (sh *strictHandler) GetWeaponTypePerformance(ctx *gin.Context, params GetWeaponTypePerformanceParams) {
+	var request GetWeaponTypePerformanceRequestObject
 
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFireteam(ctx, request.(GetFireteamRequestObject))
+		return sh.ssi.GetWeaponTypePerformance(ctx, request.(GetWeaponTypePerformanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFireteam")
+		handler = middleware(handler, "GetWeaponTypePerformance")
 	}
 
 	response, err := handler(ctx, request)
@@ -2925,8 +7783,8 @@ func (sh *strictHandler) GetFireteam(ctx *gin.Context, params GetFireteamParams)
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetFireteamResponseObject); ok {
-		if err := validResponse.VisitGetFireteamResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetWeaponTypePerformanceResponseObject); ok {
+		if err := validResponse.VisitGetWeaponTypePerformanceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2934,23 +7792,17 @@ func (sh *strictHandler) GetFireteam(ctx *gin.Context, params GetFireteamParams)
 	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(ctx *gin.Context) {
-	var request LoginRequestObject
+// GetWeaponPerformance operation middleware
+func (sh *strictHandler) GetWeaponPerformance(ctx *gin.Context, params GetWeaponPerformanceParams) {
+	var request GetWeaponPerformanceRequestObject
 
-	var body LoginJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.GetWeaponPerformance(ctx, request.(GetWeaponPerformanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "GetWeaponPerformance")
 	}
 
 	response, err := handler(ctx, request)
@@ -2958,8 +7810,8 @@ func (sh *strictHandler) Login(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetWeaponPerformanceResponseObject); ok {
+		if err := validResponse.VisitGetWeaponPerformanceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2967,17 +7819,18 @@ func (sh *strictHandler) Login(ctx *gin.Context) {
 	}
 }
 
-// GetBestPerformingLoadouts operation middleware
-func (sh *strictHandler) GetBestPerformingLoadouts(ctx *gin.Context, params GetBestPerformingLoadoutsParams) {
-	var request GetBestPerformingLoadoutsRequestObject
+// GetPerkPerformance operation middleware
+func (sh *strictHandler) GetPerkPerformance(ctx *gin.Context, weaponHash int64, params GetPerkPerformanceParams) {
+	var request GetPerkPerformanceRequestObject
 
+	request.WeaponHash = weaponHash
 	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBestPerformingLoadouts(ctx, request.(GetBestPerformingLoadoutsRequestObject))
+		return sh.ssi.GetPerkPerformance(ctx, request.(GetPerkPerformanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBestPerformingLoadouts")
+		handler = middleware(handler, "GetPerkPerformance")
 	}
 
 	response, err := handler(ctx, request)
@@ -2985,8 +7838,8 @@ func (sh *strictHandler) GetBestPerformingLoadouts(ctx *gin.Context, params GetB
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetBestPerformingLoadoutsResponseObject); ok {
-		if err := validResponse.VisitGetBestPerformingLoadoutsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetPerkPerformanceResponseObject); ok {
+		if err := validResponse.VisitGetPerkPerformanceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3019,6 +7872,33 @@ func (sh *strictHandler) GetPing(ctx *gin.Context) {
 	}
 }
 
+// GetSharedContent operation middleware
+func (sh *strictHandler) GetSharedContent(ctx *gin.Context, token string) {
+	var request GetSharedContentRequestObject
+
+	request.Token = token
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSharedContent(ctx, request.(GetSharedContentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSharedContent")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetSharedContentResponseObject); ok {
+		if err := validResponse.VisitGetSharedContentResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // RefreshToken operation middleware
 func (sh *strictHandler) RefreshToken(ctx *gin.Context) {
 	var request RefreshTokenRequestObject
@@ -3211,10 +8091,11 @@ func (sh *strictHandler) UpdateSession(ctx *gin.Context, sessionID string, param
 }
 
 // GetSessionAggregates operation middleware
-func (sh *strictHandler) GetSessionAggregates(ctx *gin.Context, sessionId string) {
+func (sh *strictHandler) GetSessionAggregates(ctx *gin.Context, sessionId string, params GetSessionAggregatesParams) {
 	var request GetSessionAggregatesRequestObject
 
 	request.SessionId = sessionId
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetSessionAggregates(ctx, request.(GetSessionAggregatesRequestObject))
@@ -3273,6 +8154,96 @@ func (sh *strictHandler) CompleteSession(ctx *gin.Context, sessionId string, par
 	}
 }
 
+// GetShareLinks operation middleware
+func (sh *strictHandler) GetShareLinks(ctx *gin.Context, params GetShareLinksParams) {
+	var request GetShareLinksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetShareLinks(ctx, request.(GetShareLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetShareLinks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetShareLinksResponseObject); ok {
+		if err := validResponse.VisitGetShareLinksResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateShareLink operation middleware
+func (sh *strictHandler) CreateShareLink(ctx *gin.Context, params CreateShareLinkParams) {
+	var request CreateShareLinkRequestObject
+
+	request.Params = params
+
+	var body CreateShareLinkJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateShareLink(ctx, request.(CreateShareLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateShareLink")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateShareLinkResponseObject); ok {
+		if err := validResponse.VisitCreateShareLinkResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeShareLink operation middleware
+func (sh *strictHandler) RevokeShareLink(ctx *gin.Context, token string, params RevokeShareLinkParams) {
+	var request RevokeShareLinkRequestObject
+
+	request.Token = token
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeShareLink(ctx, request.(RevokeShareLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeShareLink")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RevokeShareLinkResponseObject); ok {
+		if err := validResponse.VisitRevokeShareLinkResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetSnapshots operation middleware
 func (sh *strictHandler) GetSnapshots(ctx *gin.Context, params GetSnapshotsParams) {
 	var request GetSnapshotsRequestObject
@@ -3489,6 +8460,34 @@ func (sh *strictHandler) GetUser(ctx *gin.Context, userID string) {
 	}
 }
 
+// GetUserDigests operation middleware
+func (sh *strictHandler) GetUserDigests(ctx *gin.Context, userID string, params GetUserDigestsParams) {
+	var request GetUserDigestsRequestObject
+
+	request.UserID = userID
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserDigests(ctx, request.(GetUserDigestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserDigests")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUserDigestsResponseObject); ok {
+		if err := validResponse.VisitGetUserDigestsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetUserSessions operation middleware
 func (sh *strictHandler) GetUserSessions(ctx *gin.Context, userID string, params GetUserSessionsParams) {
 	var request GetUserSessionsRequestObject
@@ -3556,114 +8555,327 @@ func (sh *strictHandler) StartUserSession(ctx *gin.Context, userID string, param
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+w9XXPcuJF/BcW7qns4SvJmk6uU3mTLu6uL7VUkOZurxA8YsmcGEQlwAVDaiWv++xUa",
-	"AAl+zXCGHHm31i8uiwM2Go1Go7/5OUpEXggOXKvo8nNUUElz0CDxr7+fvYd8AVKtWXF2c20eMR5dRmug",
-	"KcgojjjNIbrsjIsjCT+XTEIaXWpZQhypZA05NQD0pjCvKC0ZX0XbbRz9/eyjArkbvh9xCOSt/xHXcpVo",
-	"9sT05gemtJAbXKwUBUjNAAdQN6ALKo5+ORO0YGeJSGEF/Ax+0ZKeabrCF5dMgoFp3qiAmNn9Hz9QtTYD",
-	"U1CJZIVmwizSPCUsJWJJ9BqImdL83790Se61ZI8QkzciL0AzzZ4gJn8tWfJ4m9FNTEAn5ySKo6WQOdXR",
-	"ZcS4/p8/RrHHnnENKyTjwegjxuESbhKDc3sJH+/eES0QfZYITpZC9q4lJjevY/JGlglbZGAxr/E8nsqI",
-	"lUGzgdaE7QvhGLgspyv4KLP+pfvl4ii/jykozTg1w6r19651Jc4ce9tZ7t4dgmmFGaLJlaY8gZu0i+hN",
-	"arZoBZrkQhr0NGWZInQhSo0IF1RqlpQZlWRl8NmDq5/q+iBsawQRX3Ur2RPVEGzWQogMKD8IagXGAM1E",
-	"QiczQAXEQMxFCl2Cfhgi0sgpEKoBX4BkAnesOsEp1XCm2bQJHFwzhYQlSKg4Y4ykqLe6fvmgvQ7n3Fok",
-	"vMT+R+PHloBssHGwnc3TXb8UBcezJagq2n6qligW/4JEHyUL3YVhluIvkfeOM4CXuVlWUovoKI5+NjK6",
-	"yKhBkWbZ7dOtwVUK/ppyDjLAKthcA+rsiUpDfGVgvmnA/GsA88rDvAlgGuxWKwkrd6z6L7dre/zNo/+U",
-	"sIwuo/+4qDWAC3dfXrQvy/AmSPsPWM031cjro2S65dxkTSVNNMibFIcyDbnqueUrUlIpkTijJ2zMgFNK",
-	"oBrSKz3/kaxBo/jbS8IDpWvq5QlizRNkAJqmzBwZmt02WGHXvt+4E3gbgNpOOEMhSqiPgVJM8JPtaQAf",
-	"p+O0UGuhTzdfMEE44TvGH9Wxm3AfAJlE/SY2HVnMQhHckMdeSrQX1OSxxnY2id06v+HZ6ohkI7jKlOnv",
-	"GGRpV3Kd6LCUCqR9f4K2UAHpp231c/+a9foOVCG46pXXCSj1IB6hR+2+wh+JNr+SJ5qV0NWmt3EEvxQG",
-	"15seCA9GSWc5kLSUVlVlnDyvWbJGlZCGEzyzLCMLIBZcet5VGwZEmtGgKrOwTzWtjUbCUuCaLZm983cs",
-	"qpAsp3LzfixgvaaaMEVyyni2IaWCtA+shKUEtX57NMkcgENo5l4Z2OS7BkBjatDE8BfjK8LhublHdKlB",
-	"EoYr7c4ZyDmWg9I0L0ZeceYVM8EDPu2QxJl5bZbpmbp1PEL2DqcImbZFoJ49avFXP3PE9izWK+87jK+p",
-	"ghsN+Q1fiu5hXJTJI2hvxc9obgeA0YqlRp/ddz1c4yjE1By8ZKLFw7wN3TQij4fXMvU05CegXAXWz2HY",
-	"54qnDwzkNVNGUf4wVbrvABvOOvd07Xkm31LcQ1J6k8Frqlji+Zxm2Y/L6PIfuzmucTq2U2yqFgZbFEkg",
-	"vXgJGeTbP0xikApsOMfkPWoA6lz63HoGKt5sWbTBad/Js/2s1VpFQLgpG7JobO02jt54va0rBpOMKjWJ",
-	"fBYC2lqllMD1A9PZtB1pADKQIV9kkL+myeNKipKnH+/eTZqgD149zxuRCblPYttB1TvzYOTxYBPFtRXT",
-	"GVut9cwy2sLEQ0KTabuMAKwIo/pow+qNYb97TXXXqurV3+0C+lkq3MwmM7SY260+dsw/5bBWNlXzpHp7",
-	"sefE1kZYr2PY6W7VMLIAo15KSIRMexTlpt1VQ78+0u/Sdbu0FEyvtKH++7wGjvh6S5M8U0WWTCpNHJAw",
-	"GnICx00rzNBE9rr+yxPWI3pOfvIGQV7oDVlsSApLWmaaUJ4SmqaQmofmHWM0koxqkJNCJO1Ixno4BiWW",
-	"hGaZjWRoyBXGC9iTQYVQUnL2cwnkETZT0FlXutpOTvQE28N5R1n8maCpKPU+IfHODQs0r67/v41vbC2+",
-	"QooFXWQbs9Mr4CAN74S77fZYbZSGnCxKTRLKzehkTfnKjqXIAnso4FSNY3TA0wjQg5RAgwI6YYp0ytHP",
-	"qNLEwSB+FCVr67QmwLXc4MCcpoBwmD6fXUTUi/B+pd3SFg8402QBmeArc9r27DWCvD7UL9UXffFHIBS6",
-	"DVedt5YdACc2Ys9u9VJnucaqiwuvs4q3us4wF9dgonJEHK+VtAXjqULHa6pumrb5ERFOD2Q7q6U/p0VJ",
-	"9RuqYeUyKubbFvQAzmwTWphDZlszyugp7+juEWotOe7w5qSDUYtXcyC8adE6DFmxpm3CTEv6QIhmykUP",
-	"zac5uCzB42glwTo5ZwNtIdq9TGeFbOD1BK6NOLSTOkLFjnKTttyahrjdfMlS4Am8gyfIwugyF/o7Y3cY",
-	"QSzeU52sMT7+jA7QlJW54Ve2Wo8MLH9w4NozxtEHC737wzvx3H34HufuPv+BrTogPh1Ek+a7Terci1Im",
-	"jeC71abcrTWSBvf4TgdqHH1UIDuPj8PevWzQD3y2XSPtIBeC9RHfTL0LAjC157l1uR4LtXa7ScpVQY0t",
-	"PBnhNqzOAQ3mblCpi0bsSD7l3KaBE97sLwZMr4IMwuHcwkPzLsKkjp3vVgO70RY3e1/ow/kYuzjvVYxq",
-	"DbV5dZ5IZaqnq+/mmRWpIM3tQPgjdKvJxtshassk3nYcYSb8jknQQHMbUtvhYWomWOwUZbUXa4bkHWtI",
-	"pjPFYNJ2jOc0yQftsPiuCYKxB03VmKM/P6EVPU0b0YaAwlOYadlkIIPH9zSHdgIdzTL/WLUy6Jq5dVoy",
-	"ipkpB+fTefhXmVFS/F9VPnXwrJl5558++Jn9g1b23fcl7zdfT2hhrvvzFMwv5Oa68lJp2vR7TPftV/68",
-	"+WzJyuLrrgd/OuFqdhuGzgfiDcAQvSknY+X4xczal3fX4SMEdazr7iO6cc2Ef7OLnYC5RQRTDjO6AXnv",
-	"fYq7ELgNhm7j6BloIfjRnsif8HVPtvegJUvUpEV5hDpcEK6xxnvKzrO+LEvkAg2S0+ytlNYi8NLxGmsK",
-	"Nvcgn0Bei2dz0/vB1pJxDz/yRy6euQUwTiy+lbIP/Fspe2d4K2VzEoO3hvx2WOThEZYu1Y1YcqE3Vppt",
-	"gyfGV4RywnjKnlha0ox4AqUYkDgnHwT3x18BqdmBUGkgZ/BEuQVp4GjIbdpXKkDx/9JkTZ/A/PJPVFBu",
-	"qpj8P6NLV8MhFMRYI7ERpSSMW9nCBCdLKXIUO45IN/wJuNHNr2HJOPLtOWYeNRKGqKqMvfGJFfGeeN2y",
-	"CtEQpojghNaRu5joNVM2CiFBl9K6y+s7oI7x+Yy4tchSQ3oP1CyDl1lGFxn44qrj42atIF8B8rGHM24C",
-	"SqsCEswAzLJNWJ5i3iQupGp+McYMmOVXiJM3P76//fHD2w8P5OH/bt9eEmRInDEep5OawVPUUbs89IiK",
-	"5BH0nqXWq3PD/S4ZZC9dVqHh7fayY/Jsdq8QGrhmNKve34iSJKLMUs/saXVXqgu6YBkzvHlhiYmDKScr",
-	"ymoGHyDjvVvPSELa4ZNSqt2EzWDVqDwld7l8ikeR3gAoNaSWSM0dKMSzOVMpLIErV7B2PkAgdzFMDoe1",
-	"rx3Hv8rBr2RK780TGCRNYXxgAlrw5tZJ9uH0gmZeZH9k2fKyHWllFAowF/Y06y0KI+f5vBpdO7NyXNlL",
-	"i3Z7KuvuncCqrivC0rom0LokT19KF+RXdiKOpQKs/lsyJw/S6tIK+X1e0jdSM/eH0IPyw0GaTfKTpFUx",
-	"QyMbL8jSG8zRf1enDBxVTROennaoMbrKMnculL2Tn0N5nxjxj8IqJn9hHDRLYvKWg1xtYvID0KcNCnmM",
-	"miLTcfF8Tt7SZF0ln1PyCBvC6otSnU+RUz54bOhbZ1j3mLu/LV+M7sk9nXgCtHWA97lctPNPB2vrY7wf",
-	"OTxIljxWlkCTdf5i9G8C5kdVSRyjIxsz6JFc3d50VNIclHLJ5a1CZgWSLCUDnmYbknaTmHCavsoAczOV",
-	"I0rKQqOmTRSPVgWtjxqonf363Crmhj6RV4Ulgt9S3YNHxvijEenOQT0pGQBnmNOJs8N/8mlaFaHTrm+b",
-	"/oauQLUDCI6wqi0lOdVYpDMsYjunhSrF1MGqJ3pWbimTPTroh9JITMy1s7BJag6sk865C+oeX6zsELbK",
-	"DtXr0+BuQc+LukPXYG4ePwDNrbY1E/Y315WI9M5oYn06mBsm+DlxprUyaglVhHKh1yD9KO2SzzbkGSQQ",
-	"LVZgfo526+HVWq4PdZc7ChiCPM5JCCxbM7v4aBSEC7+Zc+3jo0eZngpnRf7bn54TYG89mjjPSc6OXcGs",
-	"R8ci6yxlnhqhPR/mPzFOhCSZUPMhXKGJmhfQfM5zXhtfeMbxig7PuTU/J68B0d66Ykq8b+Y8pA8sR3Ir",
-	"SARPVXsVc21EgPt2O6nCP3TrmxtaWCZs3qeFY034heZFhu8J1DB2l4nia70aoRRLlsGJ49H7Asvj6547",
-	"A2xW/QDYPsOhGZwNXu+EcXcFbrdxdA9UJmuj9d+BKrO+dNsMNXaN8A9pnLCNo0XJVwz2R7XduOsxNLa4",
-	"74fpxl3vKBPf9Xr3let9O9XK065HdnwQjd3rL1Nu7Gm15ri9HZ8OaofR2ustMgA2bRhOswbXLqMpmN4x",
-	"pVF99aMUMbaIdTChZHXdIEJX8f5GG0HbmGr664Pclg20t90oykuUMAkj1U7VOyYA3pjs9Wb8vRN02NhO",
-	"y1etJz9p47XTOHsyqvQ9AL/a2czoWTINP/Js48Ng4bwdEAfh0YNAiNfDgf0ZenE9EJV6ztlrA+SJjkQN",
-	"OpjoSxyHeuptwyHmA/cFWHW3PrVj85OtLL2t3ncP3gRgDoowlapVnvQyFUeou9TbFVQWNauOGkJ80o64",
-	"SwhvurCR0r7i2Be5Jjop/rtzvlt58HE3uXw0CJ+M/oJtzoRkK8Zpdl81huoJUl23Symdo46qKnploVod",
-	"w6gce8JqnWkP2rMepBuNy7pL+LGwcSHnGaZeDyJsaXH2oBB5XFlV8FsPTtaQPJ4xXpcK8zLLvL2XUIWx",
-	"f/Q/rcFCYh6Q7f6DDi6a7Kud9Su5PqqvWqut2qgN9Va5Ofrn5I2N/PqyzbDqOS2xuxElOcjVvkak6qjt",
-	"VeG2tmRVUyIlnbqWpFstsrutmUuGeLHIxYy9gNRbThcZ9GzwT2v0fgbZK6TIyhV2nrLvEIxG6iDYeFRv",
-	"V4+BxedvTLFFBgfh82TfmQkfj8FL9v7xzx8YSEjnnq0LdV4N0OzDD6OTlOu9O58URKtm7cnkdL/EfcUT",
-	"k7QOl/ZkDv2UAnufQ97rkUtZgvDk5uwRNr3m9RE1+C2P5FA4/2/92dnvREIz9m9A32tOtRHpTyBVED8e",
-	"6OV3eCaAS1weThW/o8/dVHGmNEsaRf+iXGTB7cLRQ39kuvi0rK/AEbyNowfnDz+i/yW2ukfAn3vj9FVs",
-	"oOuQAZof4IasYPkJP83hSW8npw/Wz930X0jcKFKaaiKhkKAwNdjs/wKUjkkuzL+Z4Cv8c0mVBqXNraBE",
-	"DsTF+kAq1OLsO7aloS5pVjVxN7LKa2BUN19Arc5Y0YuMqTWkqJs9UYbJtFXowa1p01u9MJB629+ae5YW",
-	"ywuqWDJj6AL7utl9aJ/5oxqTsWTu+2g1Z2jyFmzDfEKfQNIVVHpuJXViYwLQoshYQhsi54gwy8qGJ5+B",
-	"rdZ61ojTTw5kV3Qi+sROSVao6mL6OCffmNubLMCcPKXYijf6jR5TfOGW1bmzLR/0adcf1c6CxMN7Pvfa",
-	"++q30VL8N1kAObb48ZjCx5t2peX4gGCQ3NgT4ZopsnTI2e+ZcTsiMjXWdReGrYbLRG+aFaNqMI61I1I5",
-	"0HWoP1DZX+I1pJ/u7Zrri5rbqV6H5YO3Pqix16rBZPcg69rWJeFbC7BKiq0oG1vROPPnOSY2BJuzqjCo",
-	"g5hYxNcpC7QevKSUTG/uDequfgGoBHlV2jxL+9d3fg/+96eHyH1ACvUR/LXek7XWhQXMXKFXyyvIgWAK",
-	"L3ZqxS6njWfOSIouo2/OX52/wjh3AZwWLLqMvsVHcVT4RL4Lp7q5LVlZr5LZJaxqMcwYfQ/6qh4VNz7l",
-	"NaAj1EMu6k9sbeMRg5vf+zKv4Ie7fi4BuyL5e1SU2Itn+JtdHZbP6S8sL/Po8ttXcZQzbv/4pts/fWjO",
-	"wqYTHzKln+XV+FmavsLR3yQbDisMTYRfCwohjmkf8t5+YuiTwcyWXSLb/OHVK9twhmvgtqrBKqeGhy7+",
-	"paz3sJ5q1G3Zan3SuTE7UeIqk6DmVisWsXKtoMpoNOatgOkvPte2y3bECdh0+X//Lh65a907wLXM9N80",
-	"AFmZBn4V51iNgtyq1zU+jY9xHMlUgWk4mQG+aBObOCqE0t/THN5yLVl/JlKtLrT1NO/pP77ZZbdNYY+W",
-	"ooHm41VLdPH0IFsql7F21CWsGrlrAx2Nw2/RIc4hiTwGXT2se3yvDNLm/GJ8blVyVQVugi/ebePojwdy",
-	"2641NgtierC64U80YykxSwal7fx/fLn5PdMTjhGvkqcGhT+9LAlspQ1RWMjvCne8LE1zxi8WNHlcsiw7",
-	"qw7kWUq1PetC9YjU1+6F6lxem+GzipUlZVm3g19fq8e6Y+yo0a0D4F+N/YxjmP2+zI2JY9jd0w6wrhIb",
-	"D/VRtroezpj1QOwhbJaZ86veNL+X9JW+Q/T1QutsQRWceeV7N5GrYL4Z/ZW4AXF9Rcwuncr3AHthm+JF",
-	"tNdWf7MR2usddtewprurJavqilzyBuNJVqYQdHte2vohKoEInjEOWHMklkvzf6sZ4q36HLaJtxfItxO4",
-	"87db9NnDzPZSY4qk4plHoUmPvBga8//4tP2E7J2JFePD8uEd/myRA6Vfi3QzgdqJ62m2O4yGo/oWvJ3I",
-	"77vzHYOPzfWZZGK1Mhoc414w5NZ3crEApc/cV/8YX525KvCdbojXoPRt9co7/8YRJtkJDesqEfHIOVyO",
-	"5BD4lW9yN9Z2r7ribfd5U2p4lbPkT0c6S9w732PaaS/kb1692gd7XivTrnKHKdS9VztGWSX6DysA6jH0",
-	"anNyipu01ftsz6dnEOe68YslyBgReVc3ftKiqBLwbIsA91kJd7x9QdbQEb61QYSTCSQsE+tZQmG/P1NL",
-	"KoOs+/DfsBy/a38o8Hcuzh09sLsX0sQR0tYDDdPR1obNRsHC6R4jVONCwpL9sp/cblxsYZ+C7s0lrKl6",
-	"38yr8bmDdp4y0+MFTaf0rqtutpvO2wniCo8DxAAlmXO02m0nDpr3TqAGZS0EDAuFL2HnBFujZwBguanh",
-	"Jmc7ICthRvBOPeDejxl38x8ULHjxUMEsgYJT6C/z6CtOEw/BzVXEcmeFSaeWxT2vS1peKGLhCyAPCFVU",
-	"nIxjvPBsjn1jyxQofp23roVsSVhNpT8XX8KenuViPK5w5tDqo93p+Q7UGHl4K8UTc+Z40AZTEKxLstlO",
-	"rgpjiUZvUyB0L5RvZrvIK1bcqcy5tImKqY7wrQ/6BXZft37guGsHmctY6Mw642u1w56ei89VHUkYxGuC",
-	"+R40oVUz0MGDVF8vA7dLM7JWF7CMN/iG6mami6lpLEFrRkBpVPZQ8SP6C4OhbfrZAV9GEsWzbdF2cI9m",
-	"k3Y7i3UG01N7W2EdKqxSqqmRU9b5W+eXVpu2T1C9emFBFfDl0Lm/qPsHjFAdr+rBv71TPtBdYby90AiG",
-	"f5H4djuGHG5HjcBBUeMAxi42qVRNQ8g+CefVxh0yzg/5rUu5PSz6ZXW6Vu+LI+VjOP8knc6jc4Ra99LS",
-	"sqJcR26GR7tXSfJBqMDGr5x9rmTYfWPWuv7qL0f36VFBGsiUAzJTBuDpEv2+XHLfi9i1I/zYw+FMyl0I",
-	"0n0Nuu08bn57fHfkbbeFrLyJ7OvDmwxLeeq+YBCyd1e2I6z7+nvRvytT+lTSU9NHaBbvi+WLGsQ9PDzO",
-	"NA6GN0Toxee65H87Qp4OcOUBYrTLi4dkiFafjO/PEA36FxxvJdWdE05qyY7ay6qJfs2HqjF8h3k7vF9D",
-	"5u484uLXvp8nMHubS77uJowMfrn/oO/o7+0IeZQdPYeIe+Fj8dG1R1HBVf6i+bSvaUruwlzab15u7o+c",
-	"lnotJPs3pC+eyFt16/kVJPK6nKe3YSJv/83W71YZuuQSkWWQ+ONbvUpc0o+77YJDOXjZ7XLQ/IrFZK8Q",
-	"x2LuXKTQwcoTxiM3X65PE8UK0MsYDA3/0oCf59N+E6LmgTbj7GZZ2+UpSEZogn1vfib6WTRsWy3MBIyv",
-	"MhhmUHx1LrP293DbK2xo1WzUttMH1RzftUg6AE+RqtHOyOiyJjJC8xb9068vcobyHZueMb6qud0fH0y6",
-	"uPhsI547q9+wEmiMo3yu5MdTmg++x3efgqBAEvc78R9P/JoqPZgq3cNGoxJ4zNr3JPGchq++1hOfKu35",
-	"a57Py+X5BMfnVxF7mlXqf80k+ppJ9LKZRBh3MDebPUClzFwfjsuLi0wkNFsLpS///OrPr/AA1L+ry4sL",
-	"WrDz9A+CoyH3eJ6IPNp+2v5/AAAA//9n6EUv/64AAA==",
+	"H4sIAAAAAAAC/+y9e3McubEv+FUQvXtCdtwipRnbZ89q4/5BiZoZ7kgzuiJl3XttxRrdhe7GYTXQA6DJ",
+	"aU/wu29k4llVqOqqflD0GUU4PGJXFZAAEolEPn7522QmV2spmDB68vK3yZoqumKGKfzr2lCjv+OVYQr+",
+	"LJmeKb42XIrJy8lPVCl5r0kp7wUxS0ZW1MyWTJOZ3AjDynNysV5XnJVECmLkmsg5vragK0ZWsmSEihJ/",
+	"kWbJFJljP9q/xkS5llyY80kxYb+uK1myyUujNqyYcOj+lw1T20kxEXTFJi8n9utJMdGzJVtRIPf/VGw+",
+	"eTn5P57HIT63T/XzdGQPxUSbbQWtlIytf57+J5sZ+PWGV+ZmqZheyqpsT8B3is7gn57iKdWs4oKRH59f",
+	"4g+KzZgwYV6W9I4RI4k2dEvoVN4xMmVzqRi+vK7olik7eZpQTQxQV07ywzU10tJRz6VaUQNjkZtpxSbF",
+	"ZEV/5avNavLym2Ky4sL++0UxMds1NCU2qynMghvwJy5KeZ9ZbnwNxtoYFswuVawk99ws6xMxlwqHQUpm",
+	"GM5Vz3Bcx+lYAuV/eZGQ/k0gnQvDFo72/3n2jgGFesnXZ1eX8Dn2tGS0ZCp21XyvmCj2y4YrVnr2iv27",
+	"brRRXCxcLx81U/3t+zfGtPzgH+K+u5jyipvtj7yqdHsl8GeyoiWzU07xbc40URR3kllSQe4ZXUuhz8n1",
+	"kiqmCVWMyI2BBWR3TG3JLa+qgmhJjDS0wrcI12Qp78lqM1vCi9Rx5TNNSrqiC2xhvTGw5kyTuZIrWHCu",
+	"iN5MZxXVmkw3vCphz66VXDMFZMEI6IAR4WBsKyAZrFQIg5u0V73wzSLxeeZvMHkxWSgmaMkCJe1G3Rtj",
+	"Gr3tbs1tk46HrGJ9pODzMYTozZqpngbx+ZgGI3O0lw1/Bj65bXKk2LqFgz2+s5eHdKf8LcyYn9bGmtVm",
+	"rTbios5mjZWszWZtJhp8VBv050CtDOfCxczwO262P3BtpNriuVnndvdCe6cXk1/PJF3zs5ks2YKJM/ar",
+	"UfTM0AV+OOeKQZvwRWgE5sf/8QPVy/ZCwK+El/4ggi7h3/6jl+TaKH7LCvJartbMcMPvWEH+x4bPbt9X",
+	"dFsQZmbnJF0qLsy//7m95fYgHylOh3A1k6I9hI8f3sLZCOTzmRT26MiMpSBXrwryWm1mfFoxS/mkOHyW",
+	"kSogs0bWAcuXtgPtcpCfH1WVH7ofLrdS1q5jybThgsJrYfzZsS7kmTt9bC8f3o6hNFCGZAptqJixq4zC",
+	"c1XCEi2YISupgDxDeaVBldkYq8NQZfhsU1GFWt4uWn1Xl6OojQQivfq94nfUsGSxplJWjIpRrYZmoNFK",
+	"zujBDBAagRZXqL62VeiOSRrYBbYKza+Z4rKsi3Rq2Jnhh3Xg2rUSes4UC5wxRFLEpY4fj1rrtM+H5jGR",
+	"PmwIyBobJ8tZ393xo0myPRuCKsxt6yDYRxa6A+MhOUTeOc5gAnTbv01mUURPiskvIKNBCQO6qur93Xug",
+	"VUnxigrB1ORzbnGhqbM7qmDyNbT5utbm/0javPBtXiVtAnWLhWILt63yh9ul3f67blrNwzI9Ccr8Bot8",
+	"E9683EumW86dLamiM8PUVYmvcsNWut3zQ5hKqhTdjumw1gN2qRg1rLwwx9+SsWkUfzuncKR0LZ0AnE63",
+	"10YxsTDLXSv8tvaylUY4ZjFD9qFlyWHD0ep9jZH62rxy+/d90tTDATswJQkFiayqzfotF7e6j8QWhzQU",
+	"YEHXeikNubokjM6WJDDCM02SLsk91aSi2njbCNmIkinCreFEG2o0sSTpgtyyLSvJdBtbI1eX5+RCELZa",
+	"my2x1JAVo0Lj9/E96Md3AZo4HMyUaEfmOfmoGZ7ht4ytfYdkJpViM0Pul8zSQ/3mh/ugYmcVF7esPJ8c",
+	"MP/pfONll2nNpTjZjkzax+7cDJyuv6SDtMOdHNZrpEoaOYj769S0TlKeHqC109TL+OaA6nu8tpz1yW5I",
+	"31QyZm9Wm5Kb7ziryva5cyJRt9FM2e8P0PVCI/m5DY/zYzbLD0yDsSZ72s6Y1jfylmUuTRf4kBh4Su5o",
+	"tWHtu9ADmk+B1qtMCzdwxeIrRsqNshcNLsj9ks+sJY+mHdzzqiJTRmxz5Xlb6es4kNCQ4W1uuYtFtMgR",
+	"XjJh+Jxbja1nUGvFV1Rt3w1t2CypAXm2olxUW7LRrMw1q9hcMb18s/eUuQbGzJn7pGORP9QahIsinQF/",
+	"wRkg2H19jejcwLmCI233GYcJA9CGrtYDFRT4BDq4wV9bU+Iu6U2WyXTd2B4pe6ddpEzbmKDMGjX4K88c",
+	"hd2LceS5zfiKanZl2OpKzGV7M043s1tmvA3miMaSpGG0QaCxddfxcIlvIaWw8WYH3le5t4DUTQD7t9e4",
+	"qBu2OsHMhWZ9H8A+F6K84Uxdcg3XnJ8Ole49zaa9Hru7Zj8Hn1LCt4TurldU85nnc1pVP88nL//Wz3G1",
+	"3fFwyI24QcEDiiSmvHhJGeRP3x7EIKHZtI+D16jWUOvQx4lOWL5hj0h2ey/P5lmrMYr450EmimltaR+K",
+	"yWuvt7XFIPpoDpo+2wJ0M9soxYS54aY6bEVqDUHLbDWt2OoVnd0ulNyIEqyih3SQay/281pWUu2S2Pal",
+	"8M1xKPJ08APFtbv588XSHFlG2zZxk9DZYauMDVgRRs3eF6vXwH4QAtC+VWX1dzuAPEuli1lnhgZzu9EX",
+	"jvkP2azhTlXfqf6+mLlFqNmS37GMhnzhngQjgfUUL3lZMhE8vKRkc7qpTHiLVFwbMgXzgmLklq0NepLR",
+	"FxzMB9qGOljzwaQ4wDwf6G/Y87I+CqeIhtfIlIGurNhMqjKj9dcvkbH1yz1NgG0LYENb9hooKvPB6hKm",
+	"Fow4c67AWmQbmRRDVPS9bYgNj1ftz8ll/MtPbDQnffK3G7RLTbeBTcB/T8vSmrHgG7gBk4oapg7y1jWd",
+	"anN6JxU3mSvJpyVzsRCu8yWFu5+6ZWV9tqkmlIR2DmHS0AhQtux21II3s6qQCjREoVON3wGdhJKN4L9s",
+	"YEttD5moZVCJe/eIn4Ude2JPGzIt5cbsth7b1xIFt+0ka9Jb2Iv1WskpnVZb4MEFE0xRY1nO86HjPr3V",
+	"hq1QXM2ogLdnSyoW9l2K/LFjBvA/+6jaihpobbCK7abjg/0MdOyGNQAfNOfDCmpudM2WfE5+koYgSTYI",
+	"L928h1hzz056BI+6RgAJQIx/2nCtw84v2ZwLVpKKTlml0fIDW06qBRX8n3FKtFO4j2sZxt/Q0LguDzkR",
+	"0H3g2iD+LUqW1q1GmDBqiy9iGA60w8350U+OOAhvO+0/hDfamqOmrJJiAaJux0bDJi/H2l5z/mEvf9Kz",
+	"uK48FNE6i38t7Y3M7fU41KOoakE5Q5UtcH9bVXPaE5fB2La/5t08L08V3LKk+qpuf9rj/PSNPBzVmnVM",
+	"qwk1r6lhCxfzdbxlQSv3ke0ets0u00Q9DsLPvJt3T1BjyEWLNw/aGPEASDfEhaDVVvOMLMdX8HwjhjOl",
+	"ffg4VzVXKwepqLlYVDbKPBOL2oil23XTxX1DzVK/Z+odNbPlwNDJJPKz4ZWwD7z7F6i0gbYuhjp6bK3X",
+	"wt9cyCxMQT4ctjvKKFrnO8LIkE1mcrViogyxT4MO8A/1z1LFIJymgxp6LZViVWilduA+FJN7Lj64gJSx",
+	"EaWN4CCcpiKJM/VNtxbajyXnKsjS3Zr8JIAB2BRZ14ZWOx6Na1qQSt4zbdnb3gDbzJsXZ27Sf+i9dPhQ",
+	"A6sQocZW4I+3bOu2jWO7Zy0mSz1HTO2xuGDbbK9qY53CGMIhbHvrnf4PLbZt++tmddkRUxWWfLGEKb/n",
+	"gihqGC6BIlOw7hC6kmLhhA1+wYTcLJY+86GIqQ8uchUY65kO+Q/t1dtLjtQ/uuRzF/eWu6zDm8hnSCNZ",
+	"cbHROdJI2Xh1SJz2rmB2Lv7qD7L200GMm+W2fHtjxEF4u2/yPnkW6J40zyWTYkSfb4GjXgFDtft8m3Cb",
+	"26H/91/+DewqGv3Khqk7WvlHY7oftLMmyaLl5WF7DBkZ2Z7cHqbt3cs3nKl+Gdq4+Gpyv5S6tsHnrKpw",
+	"u6JIg2F27cM8Y+21R2/LU2eKJJurzUPa2LGj3ub1CrfC7bZMdpZvlixtpOR33BnwvnlRELR3sxKz/bKN",
+	"jt6P2YE22HYHk6Y5Im5JcSl6TvQs+3nfTUNPrNZL2lQQD8uJwBZhkNOMwn9Q01Or7WPOCxNHbdq2aJem",
+	"PGrLimXjusuJH4abqMLN3EH3Det7w+WGU5trKa55Tl++kYZWGk0oUgSJ48wJ2mpKs9BG5n5R/udGG1b+",
+	"mBH4kBqqZ7SyGwty7bRRqGXELp5pAvG3nGlyzxQjVrm0uaPwGr1jii4YvrQF8qbSLAN9mNK3oCumCV1Q",
+	"8Du7Lpgicu30MxskikNcSTXwPKNac216pWb+2XDhWNL2lP3BZpf9N+L6/yN5TsJmP0zi4iMQDVxsBouu",
+	"Vmx0w6HmVofN53zGmZhtPQfF2Z/TGSsLIu+89sW0v/nZRWXa8NVgRaP34OjWu9hMilK/x4tn/tsYybkr",
+	"9DK8eXmysyAhJigx7VTBwBmeW8NBUR+vOyeA49LTosERRbqZ80eHlwM3TJtc8J4GPwRe+My9PNN4pmq+",
+	"EBjjCBqNgdPbWefLoCfZT1bMKD4jU2buGRN+jxOKtg//1zQngrxF/nubA9Kk641jsNKJCm3AnyIYK62r",
+	"AKPZfQdGugRuy8ZTzRR4jBNikXn/48W/kTUoiufkfzMliYQncaimIBuhWYwzV6BbC1kbtCJCGn/PsqQZ",
+	"GbaDM2lnpE+PXo+ag42bTFT7u/DrdNgmW/+1ZaTrfjkZdb9rMhk71+lkEWpn+y//Rip2x6q2Z7K5P8pU",
+	"/V57fSmlJM/AYs5L+OrK3TYyIk3EFcCVBkcT3FNm4eNwV2nzov90sHi9Z2rgu5v1euC7jblK5Kvtz7fV",
+	"P0VvcSWSZC0hzXfuViSkvw5ViGKwYiXfrCbFBEwMA/O0fnLNNXssJj/Z1tsP3sr79o/vsO/27z/wRauJ",
+	"z6N0qPq3D7XZuZYbNavlslm/q3OxDJyDa/ym1WqBvrzWz/tR7z4G8pMg2nac26iYLhu0e3Wo4yJpJoYC",
+	"NzxB+7Ya4yAVFXpNFRPmYIKbbbUU+qTv2iy1ySjclB+i55dxQXF9MYPlIknI707VH5vGmOZI9n4bXuwy",
+	"TGflziVfZHWK680KgtuthgA76xlcFNhtkcRASDTnzpas3FTsnNwgPI+6Rawe0B2WZlWRqSwRNYRhMhqa",
+	"VeiKgVQ3zN0OhEySMSBoQrBKt2X8lGnzNsZ7DAt1sOPzn7VDHdyTBFeGaYPgOs4wC8qIYNr7TqghFaMa",
+	"zk3FbI6GPiTKIR0UbsUjxXeXjbjusGqnSBdNG8fOJK2Gm+/tEn0vaXVICITtE3oHttt1lfjh5t0o+AJs",
+	"84S5sH7fHLTmoRFoUbD7TxadZ+RC2K8OWYqk6wdMomJ3XG702E1rTSSZPWsfBIM1Y7cO4+qQjRioTDJH",
+	"M3eaa/eEaEMVCMFyA8sUKDnIVBW6ffCgOIdM2t5T4Xq2ZzgT5Wga8KPDSLBN1MJ/+jbdx/0jeooJrNsb",
+	"cQJkC9+w7+QaeOY03dimsSOpHuOcxG4e8aCsDas7AxYNN2UtkyVOT1zqZIuHnZZIKs/4NTHqz7VEWrvD",
+	"pn68HqRY4jJYpTIei+3LMsqaakuAIqtryXsyp0ko8kKaXFTKkrO7miVun0B538pxQ58MVQtmhty0R0VG",
+	"YqP5AKjDm+8PgHKdx1CnMHOH84jVlwKfJBu+ySrewhaj0R2/cOODmlhp7cT+FGtzzjDz+ohR3HrFp8us",
+	"PErxsY0cORRvsGH6Ohqm94JzsHJ7uD17jNh0rT6MtHSn/s3DmTW94dT0lC6/WHrtdFRZDqXuFkpnSmrt",
+	"UCZD/OsIJ/yYMdg2HgZ7mcZvgy7/0ZiWsImT7KlTs2ane2ZcaxnUkZGe/MM5/Sbqzqky3A40xdyQ9A7z",
+	"TFuQVE3umNLOg+A1EiuVyXupEWDLehZsFh1frZW8YysmjP4XltuPLP5OJupuws2ldp3OqnDwpKaxOZBT",
+	"p2Rz7S63G4MeKxdyA79pd+NtL/jy+PAHMc/raAHzT1veHUuHaPBcPfXDSyLf8eGs5y03yHt4A2qboncm",
+	"Z0StJn31ZGkbsTv/3tGTOWIXfGT7A/I7Ds7eG5M6cRiLWI6ADr/jihlGVxa6JuOT8vrUiBjsmC1+BIhD",
+	"fVJb+KlsuA2UqL4OkndHdVXrI28Fqb3StIUkM3wIM83rDJTy1LXPy+iMd6NEM4xW8Yp9mpYiVYIp6LvJ",
+	"GDJOHya2ZwztScKSdquxMRKpX595KCYQrdMEhKVV5X/WDUTYOlasUdzawEbjw/r2L6pqEokI+ODJb3Uk",
+	"Wf/rje/Z/9BAk/1eyc06p2cBBaXnuZ8FNDS7RZVL29QnO0Lm/JlLTLaoGC2ZmkqqMoUG/tWRV7m444aV",
+	"DqiymVar07xaTdzLPs1d3gtb/cFgrRHxzCAa2xpe2TIzLr05xem2JF3qUeOJA4kSeOiw8A/AWFkXhFb3",
+	"dAs/zqpN6f05ONRzcuUmwH5HFTBKtXXQExh/ZpZs62bhfN8JcLSPG38c8HFNXzjyAUnXdvtYlo3TiQbG",
+	"GRUOAIHwXdAPtrtRnOwpbCW9ny4VPXfYOnXNU5OyYD0l/DiJ3ji9OObvNyKf2n3C7OtlPp8DngA4cZL9",
+	"d37cMg/hAno8Dr/L57nchKjN042m32fgLoneY5CSdxDnOH6BXnO41y0+wqb2Bd74iPgy0KENET0EfgOf",
+	"IN22TlBQMPsIeJ+8is7XEAmxz3DsxdpP2zuMl9YHDeo+xEc0uCAdY6T7kJXnmdW2XGCYErR6o5QNQPS6",
+	"4CVWBNleM3XH1KV1c/qXbeCk+/GjuBXyXtgGhimBb5TKNf9GqWwPb5SqdwJ0G7Z63y3ycAsrB3VM7HTh",
+	"tUPBsrE7ONwppD1i7tmGVsRPUIlISYBnExJyNCORHfDoV6xid9QFrkE7hq3sMVhKpsUzqxXBk7+jHnAV",
+	"MBn/PnnpKrBIzQqscLKVG0W4sLKFywQKzU3Slbhjwki1vQzZ1Jm4OKpDbOlwYM1dEGfzgB0FQeOoGocP",
+	"CmechHB+xcxGWV0qngHhzYCIDCXkvF6F0zwpJmJTVRQuRq5y2f5KcwMXbc3UbU4HTGZar9kMgw6rapsW",
+	"l4EviYPUgyczNLdLEQknr39+9/7nn978dENu/tf7Ny8JMiT2WAyzlcDLh5hJ7PBgoFrObpnZMdQ4Ove6",
+	"XyUg9qVDlQbebg67IPewemtpmDCcVuH7rdxAcldVemYvY4mB56GY2nM7mfgyFQTyxAKDd0zjtRvPwIm0",
+	"rx8ylX4C61BTgyJm3OHyuRg09dDAxsA91NDmCmA+S0FKNmdCu3JT5x0TVEdM2B/MqnnsOP7Vrv0gU7In",
+	"T3L9rAvjkQDEyZcPTrJ3w0vWcbHz6BOWl+2bVkahAHN4bDDe9RrkvDiuRtdE1h5WtKYxdzvqYl07gRWO",
+	"K8LLWNHLZkCcvhBWgq/duhh6zLM5d/IgQoCk/H7cqa9Bc+/G9kuKh3XO2UH2+zIUs6ihMfOI0txZo+Ft",
+	"NDm1uR8E53AY/SA9m7h+AJJqDRmFjXRHBJih8jah8I0wNkGhiaBjswlHtGTV6QkWJ5VrNuLLa3wfaJBD",
+	"p6axWrbHQHRhpxkb9FOyY6XsPGSsji54ZV3RmbMsJibFIhTQwaAWG8US1U6uogaV8en3+kWGYEL5zr34",
+	"qGNTpkUjqLhtN2OjEKTwalFtYBgXjUq2Id+ckxseLGdoWSWUQKP5DMthsb4Ova/7Ev/X9AKvLM/HFR6Z",
+	"xYdT0BlbetdAjNjBLe/C5mhma1JT5xCk27lGlCbT7Tn5h70NYt3Rf/gazgitBL/U8J7sm6iY/cNa7D9x",
+	"Uf8IHAlgBLVmdTSNa644spu/CTZQLpL+gycA2h1492vNw4/QfOvXT6G/9qMaAa3HNwlF9Xm/9pKlOe0S",
+	"q09ZFmlu0nPyj7niTJT6H/AWblLYo8DHyODO2hlCooFHCvIP77XCr/zvz8KtIni1gt4cfBJ+tdGePAcu",
+	"tOr4Lxu2sVmxhV1T7BjbD0tuvRz4IF1CNwJgfNftpHBmxNGrhrP4XWiw9SR20HxkfTO4KElg+j4l21IV",
+	"sVUq7QKQojf2joIXz/v0UjND8QMaeUF+5IIZPivIG8HUYluQHxi92+LkWghAm5d2f07eQJK6r7BDPYxZ",
+	"wFU+COK2SsIi3cTEXP+MlssoKlhL+G8EB0FJdy8jeIg3XWKeXXdoJN2ddVrDMAFEmfGf3JbDv0F4gyxk",
+	"xrjvEx/smA+beZNwD2pj/TSIy0p8v5Y+FzizlvI+ZLYjn2IoH0qgANCSIE52Bfb1D6+VdW+VLNV5ZiYA",
+	"MQjz6yTjdBtUBRRK2jB7QNkSgWYpy1FoZftQ3tbcFBvi7Q5Y10htfiHCzoFFsGM+J7ell9+K3kOiyzmZ",
+	"0i3TnIofS6KXCorU1TAsnml4jRh5Dwd4zQ72zIYvAyT7j88vET4ndIrnx5zdOygKwVhpax+zOzsJsOeB",
+	"KgQSPCctuDSnKzgHbdWGe3NQbzhnEfDNL6lHfDsnFke83txMCg3vox1M1YDB3QgKd+3maP7U64ojJrrH",
+	"Ra0pE3EGO3DfbA9Dz6ba2lp1ovbTq7S7+qNPmc7rbzh49M8pE5ksD31f8dmtTGaHxrnxaNq2aJrV9C1u",
+	"YtAb5MbM5CpAqknlM6UDbA9THrzVpllHlB/LsajMO9MaVykskGuzjvhTQ3uiog72VNTQgjAQJ4HmiP15",
+	"4KdIC/sFzOkOQMpNwDm5ZHfc2VzdnqHa8rfvh8bRtUMtEvbLXWhxxh3iyj23vnhS+i6LYJTA/eO33ABh",
+	"NQ7yIZJo7T+u+06CEwJRQRQzpgzlwmzjtcVYtj8ioZGuk0TNxioER6TZNdoGcPPzEweV5q/U1iR/LPQC",
+	"bHnoIliOBECNWp514GkIhV+Q6YZXrjiC3d5Wjff4tn4LrqU2KOKJYmupzDm5iG/WN7RtBu8I0qH4UOH2",
+	"trwXBfGSeykrv7e5QIkbdy18njEeRNnQDSu2ZsqRlooSl3ITxMlxmTOhK/p6D+RO38hDt96TGTK+eeLR",
+	"WmqALlymfa9DNxhwGYtiN0zG7gkwMeopyFGh8jP+dXUZh7FHmjYS3+HEhn2YrGoRdDb71eeDLk61auAP",
+	"xeQdXXemQN54WA+v2uJNf0XXRaLgImjiUm3EbZcCB284i9eKrkGFC5fvajO73drN7d3A91zsm42zP9re",
+	"I0DpHTGN0C5bIwqlaUftqnYuUwh+uh4ZItzAkunbYwln7Ygt5iu6YB/VTvAR+x5U1DtOPHIlZx3qRur6",
+	"WNF1zlXQy2mKObS3nEfqh3rc14qun+mA/Z84fwY6fOL8xF5PB/SYjiyZweK4odaxNnAmUO9fK7vBZKqm",
+	"Hui7MxYpLBdXaRyQVzK2/Pwa2uGB+ajhWAeD3rqO7E2ddRy1KDl3ypb9Ldp2BaFqtmTQY+EQLppm2poC",
+	"Z690zr6O5d42msUqS675hTRgQwRrIhcOaDKfvtfwrhq2snGWcp60V2BaoGYuGskTrE8UfXnc9L/rJc0p",
+	"ZPhza11wyrDolMOGPK46Fuk5ZXJiw4tmtc2Vr9NiLW4b7eMTnhocQqKRNwpCcG24mJnEZ2mt73Ysh6F3",
+	"pyr8Brb0MLZZJ5Pbntojck5CUyOLuaPwgw9E2LH6/2J59k7zi4p/PEaTGYqHadxw4ZztP0cN/YC35g7f",
+	"acuFhjOZBTpBIzuq6lgTyaNKcFGCu+f7PLqhS2mAv9B4y0ORx80ajWikpFsiq4wFKxHLbTNDKuPCi0h8",
+	"QVZSm8Ago+I04rF4QGhcQjesNvtVGj7LBRUAmTjd9hU3HP3FB+ApBupHhM+M6AFbheYXSUrdsOjBkIR3",
+	"EExa6PfRIR5PhoM48LQU3nVRyvuC0Lr+JX0hrCAWYkAy6l1HO17HH4ou2bU2gKOdkIMDoUa0byzGbZJC",
+	"0bX/n8rGv0/AJ90ED96XQMAn+81BO9P1m7/dhG0b6KtHnuWO0fuAQuflWjGpafzHgqBbxbP2wR29n8Ik",
+	"tn2nc6rIlM5ubb2S1UbA5R/acGZuUkl5qwvCBMb/hxuRewpaT6A8dReWuOYOUnMlhRmKbx4JvsQm4t+f",
+	"bGPxh3e22Ydi4gVFSIZpVFSBFBTC4KEOflMpGDHwEbl4f9U69ldMg+LTUS7YhuVUW5I886ohdpMz1WhD",
+	"zWZ3iEwtr6eVSe7ICq3l1C1MUHh6mYUQpH7Cq+17mvMEVRwtwsRhhxx098UeTggwk6YQHiICYoIJcEKv",
+	"gTZ5mLpMgmvXK7ewz0P8vStDA/XoBa5qH25bBkfC357sS/YslTr0mo0tHcVlZF1tFgNYrdMospdlt2ek",
+	"+M6wgYb4JF9ZrnFdigaVQyozNm7fdLWuGNH8n8x6tO03KchHYgVr80Kyu0e4O9aKzbjmPiK0zZv+uRtt",
+	"tB8lxHCjWTXPdhzaz1/pg8nhPp1SF3eoGFnXuy9ChZ0XeJv8Ztice7tr40DiNrrH5i9FvtUcHc4FYeeL",
+	"c3KjKDcFeUWVYhWRiryjC/pPLrI5A2l0b6a/fSbwJJb7OorWbnN9PWq5wTPOhl9f6eYe6rdNvK9nJbcj",
+	"Uu0LBN+wYQL+utIXo9q2I2Be3TYs0IgsNQzue0+5yqSr/RR2aoI9ZytdApfa+IOQ0xfKSbuJ2fuIqY3m",
+	"oV747egjc22TUgp2vBE4gh9qXsGj0+7OuKOSngCaws8QRXBVHpH6q8ugJIdgd3eQgeyX4pxcJTHKFFjN",
+	"Mpp7y7hbwtZKUiMXDB5P+pMRw1gux2JZuRlwpS0FLdmjbDPX1/F2VY34iFd7pDEoarj0QwgFEo9GvIfC",
+	"LempaE4KPJ6Aelp3Hp2IcY4qCBI3FavY4zA99nSCEyUZgcvmRovDEYcDPhypSCX18VYgkIk0b9ZMPcoi",
+	"oOyF3o43kEi7D2s75okSkxXxNElcaPZEsdneB48CyUb6+YrFqqVHGsUNt1HerkpocxTHWoqE9oeHQ1Bq",
+	"UjAatAZIu5/qiuna7TL2K94C4TuJ1oz+3Nt1Pc4/UaiVnPOKnRiWdBe+aIePYyfOJ/ipEf2oo9mcPbie",
+	"U5p83uisH7/zoZh8kFW1Wb/CFLhODE5Q6z32dIrKabMZ5dzmoeSKh8Qqdt1hZv4tV1tWlsyl5BWg58k5",
+	"1vWsKBakXdDDXBOBonq9pD6GsDPkUdoPRoayzcWSWVlbi5vlGHgbLs/PNAmBaAeG4nYiSoXqNscAk3JJ",
+	"TDjhtalsZzpshEDAn6SgQ4iWshyGqSz6lw0CbmKklpLlZmaI3qw0oVUl78kdVRyNi1OqHXoKeNZmuiAV",
+	"v2UYlJupEKuLEAev2JliFJ0PNjYEOj/feb0+oAB/z9X2gFaz182jFLWw/7y2C5EtBRkiyGHunmmvO7u1",
+	"O8gEX+88dwPbv+32deiYkV34r8sOO3Vm0pwRclMZvq64FQUgDIOl6kCiLpMyJfD3mAW1tB1jPWtdnyTP",
+	"qH5VOaDNxoVhR6n6ES3Xm2pr9ge0XNeyv1zZlBgE3Sp5nzJAU7jUd01jr9eWtjZnRV2oHqm4oTvAtnlo",
+	"CFs/nRKFb/lzLB4h9kiLgSXuemKTeFMwDp8ikXq3w2eTmLUx0L8dqX6dNBJ/vU6aGxuK7dvpKlqSO9Rr",
+	"kCOdYDW7a4/a1558bZC202n/dhttPbQzLvZPnBhBRtrnKaVKPeeiU8Y0ZiV3z7l2YZm7EoZcH+4GErXv",
+	"Ss5oRZZyo1DvxNjNpJpstDz7pGsbqGmbIVyTtWKaCVPUEMOlZkHrdD1nAvzlZsT91Q/UXekyl1i47P9T",
+	"ivwNFkZT0u3x+mveLXznhRtX0mXfunXdUNNogtQaEotEuHK7UjC7fG7ZcAUV/txYzLGJYR2U/dDoDP14",
+	"L4iR5Ns/YSZJk4fcC3+43oiSbv8Ib/571kn6X69ahZvDI+dSXTOqZkuInvrA9KbKIETSCiOfDNpO6jzf",
+	"j8SP6y4WnO1OnHTvXQ6xH1nad7fp3ru0MQd8RdX23YhaMu1PLndZoeoNJG82l7JumcoR17BXhTEXzeX4",
+	"PEqBbqz1AzIAVuzNrPtiodiCGpat/PCWa6z8Ed7SBGK6LCwEWo1dKeB9KzfE7scVb6iR/dAGZO5bsvjq",
+	"5SFAyXACVOxUpUySxmudvdoOt6lfbEpuvuOsOrCgeNr5Q9NQd8RgxhPFxVdUm2vGxIWzeOY55F5xw34W",
+	"1dYjaqf9tpoYRUeGgJQu8GxoQ1frwWyUoXUkKbHPI9f9tZX9T7ElYtNJR19iO8SuH2qBxf5+urbh2pO4",
+	"awfeTp2Qfh++dz+8TpoZQyXQ9TAWGnRU/p4Thjm/TFyuQEBdShd1IX7QirhDCE+6JVXsLReZIOxHKz3F",
+	"fl3Ds4uMLvzz2rpJsKi/A7ayEGB4t4KAaW3kWpN7qW6bKEbHoDTSFkVuI8heLDZMazqtGDHylgmfWokk",
+	"rjfTis8IQDAUx5fVimm5UXnMhKRMjq/GLpVXQMiUwd3GViDbQVnoZORNP5BmKb2Tt56ZGjZiZmKuhq35",
+	"Zd/WYZGPvqyRnBRwoPe6ClOFBryaiBhXs8qN5RHlCnZVY5RUvoRteJA4CSIkCJSb3khiT4wvgWf38Vpy",
+	"BAyWie3Scy7afq3QGng2eCquYwvxt9jUeKulb7BDZn4Bvdp7JN+yO1YNx7u0r9dauMZlGd6Ee/+heLyz",
+	"Qiq+4FC7p4Y/tFPy2fBnn/ZupNuV9lI2YFe2uh21ZhmirRsI+fCq7Dn37FhokNt8bmn2Tdm9AyOzRQKn",
+	"2+Tl2ZLNbs+4OCefHKow1MHxR9OMaqy7whSLpyn3DdmzFn6f09muqnp+JJd7qCF+MsYtaIonf05e+wT5",
+	"kiamV3jmrXiUrJhasF3j2Gt5dbqsDSFcV+GamzWz+XqlciJ/wNJxbbZVB+AvThbWtyLCYb3eh9nT52TF",
+	"Voys+QwwMomiopQr8v7uPZlX9E5uFCvxsyImDd4xRB8sNVo+Q/UovZnGCP6QlYspIX+fXMuKKvLDRhim",
+	"XpILC4pzvcY45P9GvtvA8v99kjqrgKy0FN3d4MtAc1re2ZZav1+mTSfzeZ1FfPjZAqRKX3sqzCCBXRVW",
+	"CraNYPehykVB4Ob4EfVAl6UmtSGKzWzuhWZMJLxsP0nBg6CMFV8soUEIO8E3cIod8GvyPIeai+/XnIBJ",
+	"fUhP2qSYJF2OBcRN5+110nr6+9vYU/rz+1qv6ZMEB9cVXHq01NDjIQ5x/UbAbaDsdvXGClmY/gfLyew3",
+	"BMHgTYL1npawH0pKoMDS81eu+bRio+i5s98ciR5Pga/sA2rY5ZFQyXLtpf3ccKZYeeze2q0eGwNps/hh",
+	"cCHUuHbnByI8uF4zQJvuSciHO1a9UO1Kq8GmD3XR9giQ9HVqs2HIJZ9he2p7dsu2Wbv76MJmjt7veGWY",
+	"yoWqKiXvNSkhoT/1L/qaOKir2WoxsP9LvAF5B7BmKNrtNJDVRhssbFiQjag9sjC+fCGkYmURCn1UXNde",
+	"wr4JFdtY9wcLyuhz8p5qOKgQSa5kbO0qWbpT/B9zHN7fVnQNy8/057+9+Pzfv/n2T3/fvHjx7b+7p6AP",
+	"fP7v37749s9nL745e/HNzYsXL/F///sfba+oR8hpHLVQ6dr6uFuuWIq2C6+SwpFqLxXDSlQF0vPAkkwn",
+	"uJIaE/AtFamXZkB6dqs8Ff11B/RzdshRIQ9Izc8CbHqCkYw6v7GKBc4Jruc5edeEVG8isgO/sF+x09Ia",
+	"XjSrFx7uScvm4hHGVDH6yIPiq83q2kaXDRxSvfao41OMbq/Rv4It5+LWJqGrycsXOQ5ayZIN41QXjs6Z",
+	"Jn+AvmG76z8mvOt272spjJKYkN2svnQgd4fb29AJU2wmVRnjKmzNW9eM3tcjGm6euo5KPJSq1D+L9LgW",
+	"9icoXCGRIn9+jCKFxrsBplDVqgRji8WIeAMjBxLgmHjK5lKxPeSsvf51MXCPYKiNz7aiEwDTgzj1IXeH",
+	"hmPbhh/m6tnOFFsxYbBe74pyYSgXrKxFIqaRXxiG6SFeY1ymm83GAnKV3sI/MBqsFRbB36sBGotvwuMA",
+	"NBn8P+0T9fHNjid0Pl/4cb5OjYr1RcJHoBihX8jNu7uBh3nyilYszTFDLQhSjwS8HV/VR/cwdIzED9LG",
+	"MF/kA9O4DH6EJH3JD+c8Gv/sjd2XC6/jvcXaLpYfkyBDUBOJrOA5NkyV4litHHpTZEWhmDJ8aQOSsbpE",
+	"xc5PMkdhIh7cAXhgglQMJ8xmpK2ZCnljRcyT8j/9wIG27Xn429549s8UxgHB0Hw608hBxEonw7z29fJB",
+	"7bzQD7WySl4UFUSCdL5la6xYoAN/2fU/ZAZCVZU9awbsa4dFl3rFetyNNOyqqoQyClMWS6Ui1owtgIJ6",
+	"lAPNVezMndJStY7LOJ3PammH54DHUsXNRBWD7sBoZxvSWzFjJdkIwytsh5YrLohiaHAlinnUOm3rmR9k",
+	"i7GzMtTrmeQtgNvT2tdP4eWJTff6MevmdL+nvOBIKfzcde7HrOkunPy/5mu9vpUzWvF/WjV/RQ0wyh1T",
+	"OsHTs7eVQ+ahRsJDd+HZD/Te9ha2sk9XPC6i850j5OHQQvBx2q3hRDF6m4upVxucTbRqzzZo1Mb6sRYN",
+	"Qefi3Jkodx+jHiY0zhdSMFS3rcJ1t61wYuzOLgqsCf8QEgZFKWCTN95ZnW4jt4PcQDzVBc5efrNAU9eb",
+	"FcTh5lHGwdgEi+JGoj3CtWYmwWMrCK2kWFhNOOIQPIPbRWWIjbzK6LQWqWrYiHGJpFhgrRWtR3/0iYvh",
+	"3/SGucOYdtZS4pW5jgFn+XQ4bKh7YXyIhffw3COWO6zGUAcONvMJP7P/xpkD38uNQ9SoL8gglT8tf5VD",
+	"FQ1AKa2HhtHVCCCD0Jbv8PMxsDhqVa46K3jZq1oNXh+BQbBkMy9dvh4u5RGrohXkDw1koT8GZKT/0gXS",
+	"TlMPbUwhsdw2BFZZZdEaL3Jg4JFVbI3tMimyvZT36fNaAYEaCufRbv4DUkoCEtoOYeZrcTvslp7guOCu",
+	"CtMDr4KW7eyBcboKq6LfAhjysIC5/sCLMJjsSkZx3DY0YOFmZ+/98XkYQlQomM6cbFOqWcUFs8ATbl25",
+	"PfG8gZqSOUTjWFhuIucuPCC0Oqew2aesQu5QTIP/Bw0elhrfBxYMbjGGf/rjHnXgulCdGgTSO8or8DMX",
+	"wRHRBTKPiwMfDyYnjLhN0HewsInWnU4E/uDohD+XFD062tAtoVN5xwZCoeI6JfvC37RqOO8D58nWT8+B",
+	"HbSTHLHb0Eea1xbmr0jXNp2oLG8rJspXnZmG97XKuAjLg2LHSB+PiUeZgVbOMfHQxvkw3Lvk481rMqMV",
+	"EyXFtEQdHqPNSKGt4Z2EtMQiRKVttGNg/0PwjeIdG4PWnOyTVj7CzdlFvVCykmiqitsmbMefUjGZBXeP",
+	"6VeuyYG6UjKLFuk9+cFBvSe/XIdekh8/+A79oryH+e3PS3XqdEBLSkpS+DUZm3IKKYU/lrTd7yt8BLUn",
+	"Ly/amgZ5Tr4dpW3syDbtU4IO0nIeivx18E0sUe4xolwE4brrooiz7FkvFk8YdFe7zZBghzVuLLclHTNB",
+	"FhH7x8sLF69mjZvWfWBRT71/YWD3fZUge93AF23nrpzXPcAQWAq2aOTy2lHa9O6Ohgxvk9sTaxsMgpYx",
+	"YLWn2yRTc1jga7cd4Bp+HsR8qY3gEO47Sap1ai+oFV7tTrT2qdgl/h+dpAKooWf3pWNbz/Vrqsoeg5F9",
+	"KfBRWrN1RlXZB/ufEU/jLEo+nlqVIwXEoVtQ605+7/OEOyeBi4ZPyC9aziMHTmE5c2NjOY/hFx9vM9tj",
+	"kodVMYns5W0yY/YEfBA43a1I0dgsrS2COyJdon627yw3/QNqQAYvE77idMhykAJnK4VhRSyhjZnJHCwd",
+	"vpy5AWEbyPLtHrKafudN4l090CDQlRTLttddS0pfhYZuVMjo01pxrUMhIG6IkATNfYqwX52EOmZd60EM",
+	"E5svJn6UDeyKfl7ouqgCK1AvBaFlOOrBWfxdRe8rprVjBsWwtCDRDCqiQIe1oCqtC+LzcPu/cOb4gswp",
+	"h8Bl+7KR8hZvq8w9two8w5hFy40gTyo2N0SumbCL46FMqq17dclr4evojkU1wA0mzRYuJpYCm+bGzGCd",
+	"3k/phW89/vRd7Cf++Dr2mLzp+44/fbBU1Jety45eRxFK1s/hSqYbJG5sPw84u+kCZC4EVhP7xIXuVtNw",
+	"VYPUFVwvfYPDdK8e2UGW1qde1DIkhlZpizOYC22yI/fH2YCDLp0qVzwOH+1JU1KtvUWa56BOgYoFOYQh",
+	"CU+35Z1/OGaMtQ3/OKPcUbamzVb58Q+xzEhDq65J9eEtKVQWhpdpJ3E2QRF3XLnbJhPOIuy4JoJqoy9q",
+	"Oy2zci12zQn6j4hwA0I+eKHz0MhX+fQSVHsNNUQxBxzmjIVMG8sKBXE+L5DcGqMUpCJarpi7Iq6Z0ph7",
+	"aL9x4aYbWkXA5avLENJLTf0DW3JJGzqt7HLzxFAY0NPdmLbnucpXkKQIb3u4jl4gOroXxgitY4tMqeaz",
+	"I6Kvv4L27Do0QwLGO6cscUfOLlkcs/LF+xBI5o4Tn50ZghIKSFyl63XFZ7S2scdPB5Buq4TyxdIcFTT/",
+	"k2uyHVmB5BPbJVlgNB+MkAryDZqYpwx2ntZ8IWxtyQPAtd2wWh4qywdZoaGZ6s1FH4NQ1uk00nvGizrE",
+	"qcfKEC+PlOhVNtPJTlSGGBHOdl833HuX42CHXdsPtVoCwzEaI/xa7vA/EoDcmL2f6fFhAADdwOZr6HS5",
+	"oIMwofXp7IKr6ym2kCbk1vZKbn9bjNxXzuuTzwhMbxE+Uh0Mqyyp++4CoWnDfwjS2r5gXQ2ZS8S+oI87",
+	"IWxztQ+H2SwHv90ZYXPbVaivWaOvzzhp1+Z7mLfe6qU3IXsgXREEQEF8gqQof0FKuoJjFP5ASzEHkIN3",
+	"iafOB6qumQprm6tMDkuL4OuuO5+hAORa5S2mWdh65aPWHh+hlr9D+U+5s1bDsl7nEekadg8YUarzOCxX",
+	"++aSzx3AcF8tUEUNIysuNro1Cev6S62tOmwS7LvvOmulWvT9NPfUg/G7ReAiznvkILse99LXUPEpOY6z",
+	"zD2fucgGH9NcSghpvmVs7eC5slV5HTBWQbQkpa9av4psjW1AyMd5vsYntpQ3ZCRD9baM7nH63YNlPepz",
+	"2N1vb2REGI2fqrTDXQVL643GUVquqNFXOB0UREY6PlffIcq24TK0j5U/ccefeSa+d48nxWgp7HLIY6n5",
+	"5jIki90jqRMB1C22u/dubhK6hfyV0AaE+zubst0ZPz4CgH49OLvlyrBVkgvTxm/fCRIAGh8pGdhhbOoU",
+	"iH/71bRWNfl8YOXzo6PB60PS/5uWk4Mj1g+Mer/P8sxD4KZebaFuFU6RYZyDvFYKIVcOnZuYcRvO92PU",
+	"TOBuRFlX+prN+JzPiH8pyGTLWASTQLV3uKN0dP728IVHMIgRQbnE2PMdjvlA5eUOJ2qrhvlxQuU6apG7",
+	"E7C/BvgYxaVD5zpNgfAnKnH6TtMwIyHABFZiySuWW5o952Wg82+/ohSHlQy3sgaSBV5BiD9gkeySNiEB",
+	"20UDTrddVxPcqpwp52OwTBewpxp2a9/EcOtDx9UqB6mfXI13txgu0mAtwuHcnIgumJzjt9tgq2Rm6+Px",
+	"/Sfz02YRW45qo7jZQskMV81gyqhi6mJjlvGv7/xG+H8/3WBkPLw9eemexn2xNGZtz04u5lnsAUYw/BsJ",
+	"NBVr/OZy6iYvJ9+cvzh/AeOXaybomk9eTv6EPxWTtQ/eeR6hMODPhY1zBc7DBHGQVJPvmbmIb8HHiq6Y",
+	"re7ZYTOOrzz/n2dgXz1DQTPg5WgD8p9wGMsvG4beJm9XhSvIJF1I6+uwvJBFOljRXy12yJ9eJEAi3+Qc",
+	"WPk+13TBRnbZA1fSObJa4H13Zxn8pa5chXxHK4kQPbHFXjRz5/B5Bx89gOlfMQ1izrLNty9ewH9mUhiX",
+	"c+acFcBDz/9TW2y42NWg/XzJDOXVRagc2trHD0VHAYnIrfbMXNI7RtZUY3bsQ5Ey/fPfoi/rYcAO2Lb5",
+	"f/cq7rlqbQXB2kAJL5kwfM6ZCq4iPwo4OZAg2OGRnjjI/ZkqcRUezADd9WqHcKGDGQACAwTFzm/Di6AH",
+	"Sm2+pyv2RhjF8wVoog7QPJUixs2eV61QDS6gK+dQVgyjq+GHH+YcZojdaKb2JhQdYw+5M692gMadYWlO",
+	"p8hTkDk5W9v3AoiG/Ytq/mIjdICfFYHBgZ4/j+S2vjH6ZKo3SkmVo+pK3NGKlwSGzLSx/f/58fr3TE8E",
+	"4vZuBIby/OVxp8AwJWhlQRcUYf5FlKXliovnUzq7nfOqOgsb8qykxu51qTMi9ZX7IOzLS3j9qGLFxbU1",
+	"TuY/fZu9Pjrog0FvNzaA/zRE0g1hdhfRBuzu5w5ALLRN8szNbDgeznipB0xsVcH+1a9T99jX+e2eX0ya",
+	"ONNJToaf4EYujEur8FG9kGrhv2qUr7I3djTuow4iBSNbZiL+s5dqYKWSHqhIsbVUeAfMr2wdQvB3uaTu",
+	"+2SuOxbVn0RncIc78zeq/p0TcObh7a87prVjPJ7OWckXzCXL5bfK9ynyDrGv1zJAQqw0hC8Xdl9IYf8M",
+	"0cx/uNkwDcmT3/xfL1+8gKzJPzrEQSLYrxCI5wpKAq3WNGqTqAnXZMHvmChsbN49186ni65CC37/weMD",
+	"WZx76NmCgc3QDKwd2YCSjxPiSJtuyetKbkriK2Wq9ob1w7908zTo6hDKiwxUy30C9+cvx6meIfbi1fjx",
+	"vtwaWiCeIfPsumKGnlnh2sOzH+p4UcCiq40ABQy+d8I5BiKgxIa7tM3dtWm1Qb6jNyMVU4XjrZCyb5MD",
+	"HFWByUrKR/LYO2boBze2o3LCkRZ37zX1C/bFlN5rq/S+aSu9DmLszOO8DeYpi4DiPku0gcAmIJZcvYiY",
+	"hxfAMFDEJSaGrJyz7Bk/Chxrxd4ncIS5FB90KyGEXc1FHVNbPKUQ6QTfIQqaDQy32IlAqwm+JyTCPrLg",
+	"bIW/PflQBwfN5po1S7bSrLqzqed1/nbz8CFA6T2W9SXXcDJfuYYDysGXFMZfUG3wfBA5yO+XuUM56bOw",
+	"eSSUR7YwP4ot04/N9j3ElvkBM9DdEWSxvIifRVeQyKMBh2I9WN2IbXHjSWEBVK7mRM7n8G98D/sj966e",
+	"EVutrUXlLy/+dAB/rpjWdJFxH8K6kLniTJTVliTPvBJoLQl75556Ge1lcyNU0ZEVWhvEzlbac1t4oObg",
+	"QV5MXTt/+/zwGdnbxn0mzN29lvbVZM0QlIM5x3fIbA+vUUO4uOO+BF5Ucf2vWBbZZPQC872l6rDtlBOD",
+	"ruuaCCzZnGKt7zmtNCuOLxIH7TMc8pDtZecmLTf4dBSMokOLsDDIwC+wDqUHNrkX9ZpdLVaw333vYun2",
+	"ZobPdncxbV7JcnuQuLBh4DpXAbK2Jq6cBHAbQFpJ8p+SCxuaawXdbMbWxsZS2tKXsJ2qe7qNu+p835R7",
+	"nzKge+Jlc2F5eSlTd7o8tDbDN0djPLcH2gznYLQt3zy6Hf0VLcmHaEN/Orq8FbXPf8P/Oi9gyTBTucWf",
+	"l/h7IsldTFasvTqjgtivPfRQW6e1rRy8IYvfcq4+N4z9/Xy2gSNoRnsxqZ2bGpN+83iM8lHQjVlKBTjF",
+	"j+7pwTl5Cm6ezIG0yao1tjZiTImQqm46c1k+58Tp3FafcaHqFdew0PYCuZKA5o2IuPbnjW6+7xpDAzp8",
+	"5LSQ7A6cLalY9OzAj3jT+dfYgY995Kax9/FKL1jtMDaZExfw70982O5xsD6CzProvBFP4mD9Ki+fpFbx",
+	"HHcOtZ922QkvUJ9FO1vJZnBpjzfFZ5rEJrz/w+kg9jt0YJRl/KT21rMojjNGNtgy5Y3EKb2KlP4eZKO9",
+	"RGTNeM1AF3zx81MVQ99biR0qT3sKvvTGDEYNZ/CAwzzYNCThT+w+UDFaMjWVHpOny175Nr53AhuLnsl1",
+	"f7hpbx2bSNs1NvTQDii0q2QkUVTcFsR3FEIKrQLg6cjRGDfz2M3bMWhbzXOyxyhtglBumJ9cjgIio9kY",
+	"e1Q5KWTFZIZt4/cxeYAEenLExnptk94o4Fzkbw/EpXUhFsQZ1LRN3EG3dFIrxyLj5cjC8qBZgnrA/h6K",
+	"HsTXHEVC3nf0b+QRev+ovfuJzNl9AnDKRUISLqRFJppbUqvansy7cDAmO2ZKRlJ7g8JPeRlOJUkurDk+",
+	"jlyKE/BV1/y965rFRHtovMkHKm5r+qL1vOgiOI7gIHY3dYuNDfsx8BIefnLBRXeI1Ft8fCzNaybLAUZV",
+	"fCundJ1SyQIHz4egP2X2pFwsEFNdeLXBnhX6OZ3yanc+Db60DSlqY33aJ8wLgYCWd2NyQ773HzwMcL+m",
+	"Jb+P7CUPg9u5tunkHyOgv9lgf7x8pDPtelCAvO3HaTFNbBAL9u+RG3zLsUhj+OXq8kmKr3R0COxiY/WS",
+	"arE4QBydQ9aI01fbglOmzZnL+uZicVYl6I9dO/IV0+Z9+CRBHXxKe3OjD+tjo3ubf8StvyOvL3Yf0vb+",
+	"smfanvsGSNX5lr958WLPtuES4YtoDVL0fHlR+9mx44RmUsx56bFI9pFkjsDXsaFcapJdpZ4uOqtKxzaC",
+	"lXiQX78vZSomZh2Cf4GFdFWowtQvwi3NvscisG2yAEPkuY0LQSlu5DpKMyf3bFRCXbuYVVTrszDSbLjJ",
+	"9zHEpIlbFM4AFy9geBoZiI1bwMYUCjwiYXgocCw0Q9dmo+AKvYb7mSxZLjjVvIY2YVovBK22mv+O1Z2i",
+	"C9Cd2nUQjJXaX/K5QUfWFMsxydUK4cjH32lDWM43u0TccUXRyO3dYpJdWfu22UFKk2sysKmDW3Ao+hbU",
+	"+SnqQ6+lUqyKcehhc2aK6EfRgTaTdYp/UBMftpZWpyLk6rU9AfWnYSGLumu9uoVT7To2Bh1Dz6BeNZtJ",
+	"Ue7odjq223/xe9ggjQIYi8Onucik8NTPM8DnBbX9i1u5HtHQFHjuaduanKCorRNZMooeHfhvXe6s6Lr3",
+	"9vWO1jBbvqoIEe2l2oZAb6/NRUQvRIP3wr9DGtWKpgyclrQ6y5dUDBp8cTytIGnVKgZ0/ZT1gvpmYoYm",
+	"m6knbyBNLgtQpqGehUOqKgj7VRo+s9VQIhwSoSspFrHarCvxG+uqcEU2mi5YkZpsXBYcAltCFhN07bOE",
+	"YlLXdEve/3x9Q/pS9LLXiZjmNkxIHLh3s34/X01uWINA8SdvYT/lSZtMTc5gTbFWheOF4OPNZC8++pGH",
+	"SLiWriXF6ACsBxC5ZcueqoZeSw11MJL2Lt3YNo3jUGpzBntwkGnyndTmo04qn3w9HnuPRyfBKVZm8eEw",
+	"WD16xU7rNO8jJzjv+ynZ031+bGPqsQ2TXw2GGYPhSBthqp40bYVhoz5NlSXJkN6ptdSTo+umDjymap6f",
+	"gsxgn4EvFtzaAsqkpRAsAU+zJVYPSCk+hjT9Qs5PZFg79Jbvc9DOajTQq4/v7+r84HPjnaLyxPlbO4CG",
+	"vmPcgzh8Pb7j3F78dIGH4T+ly0+2sNXwk6/UIe6YspGiBWHni3NysWKKz+jzn9j9//e/pLo9J5dJYNrH",
+	"m9fnXUer66jvGnxSDT1wQIbNvC/AVri2V6RKzmhFlnJj5R7EAJZ0+zVzr6GGp3f56dbGNEFRCbrFaSvp",
+	"NuKos9u6Jq6NYvS2V/2+dq/8nrftjtdveGX8HXfY2zdLxfRSVuWxD0K4zO6t09ml9sVmc1ooxM3RqhrZ",
+	"UONY9I0UjtpBuASWC9NSNygrbdSP923FaJ8UXc56ZwnMO+GarBjVGwtLRLnQ1tn74/Po40CjgJVH509y",
+	"x0NhFcwVlOgSszNDEU69MsSiPbQV43TfG0ZXCOLXt/Nvwktfj+zsFXdNlRE2TzPFJ4oX8IpR5C/gOiq2",
+	"sYwAx6SgfZ3Z3+4XrvN0LsTjLrOeD7N3WFnJ4TU8PQ6Nu7Z+7tJCQk1zYYvaBmuWTeERrVpGEZrGd+Fd",
+	"0dZqnEJhbjSLDeKaEKoJDKRwZuV5aKOjrNWuazZMyhCp+h18nULrBJZ23gDPsEYuGM4E3icLQqEKcKy8",
+	"1lluZskE+WXDNqzsnlNvIXyKFxujmCh7ZSS+8FU+PiGHXces2JvF4DnBhX1lv8mYOtu1eriwMWZryYXx",
+	"MJwe5xDMOfDvQMRAz0oapJlI6G9PLqEdoWMmaayNEj9+D7O105ASp22whxNbt4uhCyKrkmnzhKOd0ksc",
+	"6rh4jevX4bDE/tmskajZGQyZCmlbnj/hXSNtZf1zcoH/IGojNNkIwyui2R0TWBgRDijFGCqeTJOQMhjR",
+	"aq0+vpBgenQl7EPYpIL9wXTSXjwUsEnA/1SltXxYKD3EhrTqfkoyfseFfQwdGvn/+JxfEyOkKgR+BIm0",
+	"5jOXG4SjtCdXUNCkcLIKtqi7VthClNZxazZKYPinrPJRnpa2167W/5c6Cw4S3FGg2Rky0g3cqztLV3Wi",
+	"GKhbRm31RdElxx470zCuU7ybtqOxLA865I8Q3YMxA1iGjK2epAhxG0TOyc+aK67dQvbKEBsDcRYqa/XJ",
+	"EJqt85XW9yJ/+AGm7TUVQoqCvN9UmpEPfF6x8/PzP+arf52TK1vnzVBeaTKTK2a3dVNkJS6POXWnKZ3d",
+	"epCHBEreklbDW4xFqjURIE9C/Dawd70wYGt3xypoX4O0ThwumSs4l9kOn9J64+36cU/TaGNpTq9Hw2vk",
+	"5XZtr+2mXSX06x0l1hmtUBvR6DSJRdBjFVH4B6OYTQiPsRLl0iIdZK8WW1+o9TFhkcdp222GyFhTOkuT",
+	"Yv35oC7OpNC8ZAozMnaAKnubhG96hNPfrQoG2Xkzb9g7jWPtCQuB3NZ9/luEz3h4vmbqdrQKn9oS02qk",
+	"0627hzJ165OVYD3Wa2eEKZJ7qdNugunbR1+nN3anG5+TlnXMvxY+Q1y6hZCKldmT9D1Tt70yaWdRWDvI",
+	"Z9ruyVgdNl/3rYZRMqJy4ZOrVvgvm5U+Tkg1+WOMiNpZPTlsAi5OI7aaQdWwAwtSUYV1XzSFWi9POuHK",
+	"5TQ4e5ULPrIziO8+h3LbfVrHe47ZwCdTD99LscgND+hK8L6syF1vphWfPce4bP38NyNvmXjolLIWXoUJ",
+	"A5RZZCRanqHx4I6zezsX2FYi76QimmnNoTj5dSo7tb88p1K18C9nXnEPUJhzo9MQLn8TjTFvG90hX7Fe",
+	"dfnaTXVe46tLSJyVUflax5UPcZyDhUStlGXLH2QncqeD2r2WhIDtFVL5WMUw8YcdQ4K1h5tTS5bh14Oc",
+	"7Ja9/VJ+RVd6rKS3JSJ5cXGbogYqdidBu5KKsF/XuJhPSqVVbK6YXnajJX2wL9wkIuZ3DZrk5gN1b5wT",
+	"N5Gawe2/ex6v7fNjzeDa1SoZUExnrdic/7p7ut17hW37FPNeH8KS6ndSsdwlu5i4i/3wiF6cXoDc+4Bf",
+	"7vRG+Q6KQMeISy1FhG9QJ+yyBzuES3at62Sq9hHaRacbseAs6LkY+W2LFCIr4bnWH9Dn3xlmERpVav7R",
+	"C80fpcz8KSCKjgNJ5Cr3pM0xAWP922TNRGnVbV9FMmFD1xn0Be+f3VEFLcIyT9z6u5P2fWim/vvr0Ogj",
+	"1a5JVLKhhe4DJ++sGkMoJkZ47bCpOiMC6XV4+Oj1t45yMCYs/fK3DCN0MbLn2R0feU7tynFArndNDbot",
+	"K3nHXfmu0AgxkmhYjNqtaC7V5DFLyARW7M1Hmrl6Mjoy7p8Pi92NdcT6j1v/4rBjB5mLcMRPh1LuUe2w",
+	"u+f5b+5frvhL3gjJDNx/12zG53zWuZG+Z93bKHP7DB2PuIHWudK3cHnq3IWdLEGJTt/JlgyxlRII7Zw/",
+	"+8KXkUTF0ZbooXONjibtavOa6f/gglXdwqqkhoKcsuUiQ4pWXNLHw8IfJqgSvuza98/rdpgdquNFfPnx",
+	"d/m/VDpGDQ13DIbq0e1ij2OoSvKJ9s8PoSl7jYSIhfGirTYpOR08ZNonkczTffFMp7WFbWBZ8nhH4kjq",
+	"kqNVZeP2IJuE+6zlMibBlxvlPW++9d5dGTT7l7/lDxSvpfccKf6Vf/VDZce5/2VVaL9Q5YU54DhK+z9I",
+	"hfbk7KFFP/bhFGaudUyh32ZYynywn+qglfdVBPWekrfwxYEbIhP+7ow2lh6rHiRR74nTqCOcRDEtN2rG",
+	"xtgRwjePVdI5TOCQC/t1skDzpBrOsHKv1pGXeuTigrs4kMzkDq4MG0fyFKrDWlO/vsjMyM9re2hbd8A2",
+	"zGRlaR+ClVKkzLVDxKUsdRQXVK3zL10mNuHf7lKxkc++OsMeHQEy2cpPtMhdO7Sgq3DuB/TlaUITliJa",
+	"ephjIQkk1DEFgMfg3c+UqIMGjiKqiicSCLD37vzgHKNfd+fTcFU/sU2Z3nR7VcfEFee/8bc2vdWGrVq5",
+	"EzlFMnR3/CJ443xvoyIXRznZvnxMZDNDR7MzLjQTmht+52Fz0mt5UAihwaKWCyJdQLdwJTFyg9De5T4C",
+	"LjvV/RuA5Bb1ji46erNPjtMVjW7hOb2TioOJsurs2r/TH7jeSwDMFL+rgbJhxh8s+Dm5aD8FCcJ+xRRh",
+	"1M9dplgXbpFv/wASY98goCgX3gqDjBCXqCfG/3jlDncS5mMurZ2K6zQjxIFAXcuKqg5K7ds3VuMejbuu",
+	"LbbswGPakX9tUVcf5eI5ANqwy+CgCQV29SZCAO3txhHEYMLZRnGzRWk+ZVQxBTE+k5d/+/zwecDFFV3O",
+	"XhDVTxYqSscD6TnUdUONCfO/M9c0/HptthUbyok/hQ9OZlwz9JbVzxg5f1T3dGYHDHNUJ6/XNKXnv0XM",
+	"hYcBalMHT4/QloZkfmwE/2XDCC+ZMHzOmYqutiRbM2ddTgEk9vVZHhEB/uC1vPZu98iHuvZ6j7O5e726",
+	"nM/HETZPfT2PIdeCZtKuZG+fgK6pmDa+bHGCfNKOW2y4tGt/Ti7jX81krZyVL6h1L9tFl9QtkLURK/hX",
+	"TY5RYJZEI2zT6P0YjdQbaiEHd1Fl6ELn5Mq6ojOXrwOvuCz7tKmgGbTb7IvU3Mu/fwxh/8gCwu7cVMJ/",
+	"tYf87uvV9Jzx+XCPruN+JquKzbzoCZ+68vC64YLpO/b7Akee8IGRPc5i7YgmVX5iOu5nB+Ry1klcxN8f",
+	"N8VzfDhKR3jH5903t4sEy7zOZ/0cvmLKhlLmL2nv4DGWb0ptf0ZCB1wsKtbNz/jpscx+vwc1yfr8riN5",
+	"u2I76u+3Y45bDZ4i46RlYWpjVgMj1A/dvzy9AGA8DghsB7AtpSWjcftg7sjz32zg9kNf8N9H60LfHe93",
+	"rDLNp7x3vVdyzvNQ5DBO4p6TKzGXdmH/dJyFzfQ1V5yJstrWrdRWnWZ4mGd0aZcZsmOYXjeI+d45Ngqt",
+	"DUoStVoGmCUdOk+bjZ6XfMG0GRa9A98806SyFZocmJxroAAbWkDuOyeX9mc0Ie8up+UayaZKw8y71o7v",
+	"TD1xofIesLU/JwlYf/n2xFBrg/QAO8lDjLSXuTX/4teJJwgkle4QC0zggqnaG3FIQiAw846kwC/C3iOc",
+	"k4+bdGi7PDjt8IR4MV/zBh8vbzDZPk8iuPqo6tfXzMSvmYmPm5mIfld15zfQRlWTl5OlMeuXz59jRaCl",
+	"1Oblf7z4jxe4AeJz/fL5c7rm5+W3UqAB5vZ8JleTh88P//8AID4KPNjlAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -117,3 +117,17 @@ func PVPName() string {
 	result = strings.ReplaceAll(result, "  ", " ")
 	return result
 }
+
+// Unique returns name unchanged when it is free, otherwise appends an increasing counter
+// ("Name #2", "Name #3", ...) until taken reports the result is not in use.
+func Unique(name string, taken func(string) bool) string {
+	if !taken(name) {
+		return name
+	}
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s #%d", name, i)
+		if !taken(candidate) {
+			return candidate
+		}
+	}
+}
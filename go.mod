@@ -7,6 +7,7 @@ toolchain go1.24.1
 require (
 	cloud.google.com/go/firestore v1.18.0
 	cloud.google.com/go/storage v1.50.0
+	github.com/algolia/algoliasearch-client-go/v4 v4.31.0
 	github.com/fatih/structs v1.1.0
 	github.com/getkin/kin-openapi v0.131.0
 	github.com/gin-contrib/cors v1.7.3
@@ -20,6 +21,7 @@ require (
 	github.com/rs/zerolog v1.34.0
 	golang.org/x/net v0.33.0
 	google.golang.org/api v0.214.0
+	google.golang.org/grpc v1.67.3
 )
 
 require (
@@ -35,7 +37,6 @@ require (
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/detectors/gcp v1.25.0 // indirect
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/metric v0.48.1 // indirect
 	github.com/GoogleCloudPlatform/opentelemetry-operations-go/internal/resourcemapping v0.48.1 // indirect
-	github.com/algolia/algoliasearch-client-go/v4 v4.31.0 // indirect
 	github.com/apapsch/go-jsonmerge/v2 v2.0.0 // indirect
 	github.com/bytedance/sonic v1.12.6 // indirect
 	github.com/bytedance/sonic/loader v0.2.1 // indirect
@@ -102,7 +103,6 @@ require (
 	google.golang.org/genproto v0.0.0-20241118233622-e639e219e697 // indirect
 	google.golang.org/genproto/googleapis/api v0.0.0-20241118233622-e639e219e697 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20241209162323-e6fa225c2576 // indirect
-	google.golang.org/grpc v1.67.3 // indirect
 	google.golang.org/protobuf v1.36.1 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -1,18 +1,28 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
 	"oneTrick/api"
+	"oneTrick/ptr"
 	"oneTrick/services/aggregate"
 	"oneTrick/services/destiny"
+	"oneTrick/services/digest"
+	"oneTrick/services/group"
+	"oneTrick/services/meta"
+	"oneTrick/services/rollup"
 	"oneTrick/services/session"
+	"oneTrick/services/share"
 	"oneTrick/services/snapshot"
 	"oneTrick/services/stats"
 	"oneTrick/services/user"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/fatih/structs"
@@ -31,6 +41,11 @@ type Server struct {
 	AggregateService  aggregate.Service
 	SessionService    session.Service
 	StatsService      stats.Service
+	ShareService      share.Service
+	RollupService     rollup.Service
+	GroupService      group.Service
+	MetaService       meta.Service
+	DigestService     digest.Service
 }
 
 func NewServer(
@@ -42,6 +57,11 @@ func NewServer(
 	sessionService session.Service,
 	manifestService destiny.ManifestService,
 	statsService stats.Service,
+	shareService share.Service,
+	rollupService rollup.Service,
+	groupService group.Service,
+	metaService meta.Service,
+	digestService digest.Service,
 ) Server {
 	return Server{
 		D2Service:         service,
@@ -52,6 +72,11 @@ func NewServer(
 		SessionService:    sessionService,
 		D2ManifestService: manifestService,
 		StatsService:      statsService,
+		ShareService:      shareService,
+		RollupService:     rollupService,
+		GroupService:      groupService,
+		MetaService:       metaService,
+		DigestService:     digestService,
 	}
 }
 
@@ -154,6 +179,23 @@ func (s Server) GetUserSessions(ctx context.Context, request api.GetUserSessions
 	return api.GetUserSessions200JSONResponse(result), nil
 }
 
+// GetUserDigests returns the user's latest weekly digests. Users can only read their own digests.
+func (s Server) GetUserDigests(ctx context.Context, request api.GetUserDigestsRequestObject) (api.GetUserDigestsResponseObject, error) {
+	if request.Params.XUserID != request.UserID {
+		return api.GetUserDigests401JSONResponse{Message: "unauthorized"}, nil
+	}
+	count := DefaultDigestCount
+	if request.Params.Count != nil {
+		count = *request.Params.Count
+	}
+	result, err := s.DigestService.GetAllByUser(ctx, request.UserID, count)
+	if err != nil {
+		log.Error().Err(err).Str("userID", request.UserID).Msg("failed to fetch digests")
+		return api.GetUserDigests500JSONResponse{Message: "failed to fetch digests"}, nil
+	}
+	return api.GetUserDigests200JSONResponse(result), nil
+}
+
 func (s Server) GetUser(ctx context.Context, request api.GetUserRequestObject) (api.GetUserResponseObject, error) {
 	u, err := s.UserService.GetUser(ctx, request.UserID)
 	if err != nil {
@@ -220,6 +262,9 @@ func (s Server) GetUser(ctx context.Context, request api.GetUserRequestObject) (
 const (
 	DefaultMinimumGames = 5
 	DefaultLoadoutCount = 10
+	// DefaultLeaderboardWindow is how far back leaderboards look when no start is given.
+	DefaultLeaderboardWindow = 7 * 24 * time.Hour
+	DefaultDigestCount       = 4
 )
 
 func (s Server) GetBestPerformingLoadouts(ctx context.Context, request api.GetBestPerformingLoadoutsRequestObject) (api.GetBestPerformingLoadoutsResponseObject, error) {
@@ -228,29 +273,517 @@ func (s Server) GetBestPerformingLoadouts(ctx context.Context, request api.GetBe
 	if request.Params.Count != nil {
 		count = *request.Params.Count
 	}
+	ranking := api.LoadoutRankingKd
+	if request.Params.Ranking != nil {
+		ranking = *request.Params.Ranking
+	}
 	minimumGames := DefaultMinimumGames
+	if ranking != api.LoadoutRankingKd {
+		// Other rankings already account for small samples
+		minimumGames = 1
+	}
 	if request.Params.MinimumGames != nil {
 		minimumGames = *request.Params.MinimumGames
 	}
-	gameModeFilter, err := s.D2Service.GetActivityModesFromGameMode(request.Params.GameMode)
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
 	if err != nil {
 		return api.GetBestPerformingLoadouts200JSONResponse{}, err
 	}
-	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, gameModeFilter)
+	character, snapshotRollups, err := s.filteredRollups(ctx, characterID, filter)
 	if err != nil {
 		return api.GetBestPerformingLoadouts200JSONResponse{}, err
 	}
-	result, performanceStats, counts, err := s.StatsService.GetBestPerformingLoadouts(ctx, aggs, characterID, int8(count), minimumGames)
+	result, performanceStats, counts, confidence, err := s.StatsService.GetBestPerformingLoadouts(ctx, *character, snapshotRollups, filter.ActivityHashes, int8(count), minimumGames, ranking)
 	if err != nil {
 		return api.GetBestPerformingLoadouts200JSONResponse{}, err
 	}
 	return api.GetBestPerformingLoadouts200JSONResponse{
+		Items:      result,
+		Stats:      performanceStats,
+		Count:      counts,
+		Confidence: confidence,
+	}, nil
+}
+
+func (s Server) GetMostUsedLoadouts(ctx context.Context, request api.GetMostUsedLoadoutsRequestObject) (api.GetMostUsedLoadoutsResponseObject, error) {
+	characterID := request.Params.CharacterID
+	count := DefaultLoadoutCount
+	if request.Params.Count != nil {
+		count = *request.Params.Count
+	}
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetMostUsedLoadouts500JSONResponse{Message: err.Error()}, nil
+	}
+	var (
+		performance map[string]api.PlayerStats
+		usage       map[string]int
+	)
+	filter = filter.WithPeriod(request.Params.From, request.Params.To)
+	if filter.ByModeOnly() {
+		_, snapshotRollups, err := s.rollups(ctx, characterID)
+		if err != nil {
+			log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch rollups")
+			return api.GetMostUsedLoadouts500JSONResponse{Message: "failed to fetch rollups"}, nil
+		}
+		performance, usage = s.StatsService.GetPerformanceFromRollups(snapshotRollups, filter.ActivityHashes)
+	} else {
+		// Rollups don't keep when or where games were played, so the rest of the filter is read from the aggregates
+		aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+		if err != nil {
+			log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch aggregates")
+			return api.GetMostUsedLoadouts500JSONResponse{Message: "failed to fetch aggregates"}, nil
+		}
+		performance, usage = s.StatsService.GetPerformanceBySnapshot(aggs, characterID)
+	}
+	result, performanceStats, counts, err := s.StatsService.GetMostUsedLoadouts(ctx, performance, usage, count)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to get most used loadouts")
+		return api.GetMostUsedLoadouts500JSONResponse{Message: "failed to get most used loadouts"}, nil
+	}
+	return api.GetMostUsedLoadouts200JSONResponse{
 		Items: result,
 		Stats: performanceStats,
 		Count: counts,
 	}, nil
 }
 
+func (s Server) GetWeaponPerformance(ctx context.Context, request api.GetWeaponPerformanceRequestObject) (api.GetWeaponPerformanceResponseObject, error) {
+	characterID := request.Params.CharacterID
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetWeaponPerformance500JSONResponse{Message: err.Error()}, nil
+	}
+	byInstance := request.Params.ByInstance != nil && *request.Params.ByInstance
+	if !byInstance && filter.ByModeOnly() {
+		character, _, err := s.rollups(ctx, characterID)
+		if err != nil {
+			log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch rollups")
+			return api.GetWeaponPerformance500JSONResponse{Message: "failed to fetch rollups"}, nil
+		}
+		result, matches := s.StatsService.GetWeaponPerformanceFromRollup(*character, filter.ActivityHashes)
+		return api.GetWeaponPerformance200JSONResponse{
+			Items:   result,
+			Matches: matches,
+		}, nil
+	}
+
+	// Instances come from the linked snapshots and the rest of the filter from the matches, which rollups don't keep
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch aggregates")
+		return api.GetWeaponPerformance500JSONResponse{Message: "failed to fetch aggregates"}, nil
+	}
+	result, err := s.StatsService.GetWeaponPerformance(ctx, aggs, characterID, byInstance)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to get weapon performance")
+		return api.GetWeaponPerformance500JSONResponse{Message: "failed to get weapon performance"}, nil
+	}
+	return api.GetWeaponPerformance200JSONResponse{
+		Items:   result,
+		Matches: len(aggs),
+	}, nil
+}
+
+func (s Server) GetPerkPerformance(ctx context.Context, request api.GetPerkPerformanceRequestObject) (api.GetPerkPerformanceResponseObject, error) {
+	characterID := request.Params.CharacterID
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetPerkPerformance500JSONResponse{Message: err.Error()}, nil
+	}
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch aggregates")
+		return api.GetPerkPerformance500JSONResponse{Message: "failed to fetch aggregates"}, nil
+	}
+	result, matches, err := s.StatsService.GetPerkPerformance(ctx, aggs, characterID, request.WeaponHash)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Int64("weaponHash", request.WeaponHash).Msg("failed to get perk performance")
+		return api.GetPerkPerformance500JSONResponse{Message: "failed to get perk performance"}, nil
+	}
+	return api.GetPerkPerformance200JSONResponse{
+		Items:   result,
+		Matches: matches,
+	}, nil
+}
+
+func (s Server) GetTrend(ctx context.Context, request api.GetTrendRequestObject) (api.GetTrendResponseObject, error) {
+	characterID := request.Params.CharacterID
+	bucket := api.TrendBucketDay
+	if request.Params.Bucket != nil {
+		bucket = *request.Params.Bucket
+	}
+	window := stats.DefaultTrendWindow
+	if request.Params.Window != nil {
+		window = *request.Params.Window
+	}
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetTrend500JSONResponse{Message: err.Error()}, nil
+	}
+	if request.Params.SnapshotID != nil {
+		filter = filter.WithSnapshot(*request.Params.SnapshotID)
+	}
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch aggregates")
+		return api.GetTrend500JSONResponse{Message: "failed to fetch aggregates"}, nil
+	}
+	return api.GetTrend200JSONResponse{
+		Bucket: bucket,
+		Items:  s.StatsService.GetTrend(aggs, characterID, bucket, window),
+	}, nil
+}
+
+func (s Server) GetMapPerformance(ctx context.Context, request api.GetMapPerformanceRequestObject) (api.GetMapPerformanceResponseObject, error) {
+	characterID := request.Params.CharacterID
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetMapPerformance500JSONResponse{Message: err.Error()}, nil
+	}
+	if request.Params.SnapshotID != nil {
+		filter = filter.WithSnapshot(*request.Params.SnapshotID)
+	}
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch aggregates")
+		return api.GetMapPerformance500JSONResponse{Message: "failed to fetch aggregates"}, nil
+	}
+	result, err := s.StatsService.GetMapPerformance(ctx, aggs, characterID)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to get map performance")
+		return api.GetMapPerformance500JSONResponse{Message: "failed to get map performance"}, nil
+	}
+	return api.GetMapPerformance200JSONResponse{Items: result}, nil
+}
+
+func (s Server) CompareLoadouts(ctx context.Context, request api.CompareLoadoutsRequestObject) (api.CompareLoadoutsResponseObject, error) {
+	params := request.Params
+	if params.A == params.B {
+		return api.CompareLoadouts400JSONResponse{Message: "cannot compare a snapshot with itself"}, nil
+	}
+	l := log.With().Str("characterID", params.CharacterID).Str("a", params.A).Str("b", params.B).Logger()
+	filter, err := s.statsFilter(ctx, params.GameMode, params.Filter)
+	if err != nil {
+		return api.CompareLoadouts500JSONResponse{Message: err.Error()}, nil
+	}
+
+	snapshots := make([]api.CharacterSnapshot, 0, 2)
+	aggs := make([][]api.Aggregate, 0, 2)
+	for _, id := range []string{params.A, params.B} {
+		snap, err := s.SnapshotService.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, snapshot.NotFound) {
+				return api.CompareLoadouts404JSONResponse{Message: "snapshot not found"}, nil
+			}
+			l.Error().Err(err).Str("snapshotID", id).Msg("failed to fetch snapshot")
+			return api.CompareLoadouts500JSONResponse{Message: "failed to fetch snapshot"}, nil
+		}
+		if snap.CharacterID != params.CharacterID {
+			return api.CompareLoadouts400JSONResponse{Message: "snapshots must belong to the character"}, nil
+		}
+		result, err := s.StatsService.GetAggregatesForSnapshot(ctx, params.CharacterID, snap.ID, filter)
+		if err != nil {
+			l.Error().Err(err).Str("snapshotID", id).Msg("failed to fetch aggregates")
+			return api.CompareLoadouts500JSONResponse{Message: "failed to fetch aggregates"}, nil
+		}
+		snapshots = append(snapshots, *snap)
+		aggs = append(aggs, result)
+	}
+
+	comparison := s.StatsService.CompareLoadouts(snapshots[0], snapshots[1], aggs[0], aggs[1], params.CharacterID)
+	return api.CompareLoadouts200JSONResponse(comparison), nil
+}
+
+func (s Server) GetRollups(ctx context.Context, request api.GetRollupsRequestObject) (api.GetRollupsResponseObject, error) {
+	character, snapshotRollups, err := s.rollups(ctx, request.Params.CharacterID)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", request.Params.CharacterID).Msg("failed to fetch rollups")
+		return api.GetRollups500JSONResponse{Message: "failed to fetch rollups"}, nil
+	}
+	return api.GetRollups200JSONResponse{
+		Character: *character,
+		Snapshots: snapshotRollups,
+	}, nil
+}
+
+func (s Server) GetTeammates(ctx context.Context, request api.GetTeammatesRequestObject) (api.GetTeammatesResponseObject, error) {
+	characterID := request.Params.CharacterID
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetTeammates500JSONResponse{Message: err.Error()}, nil
+	}
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch aggregates")
+		return api.GetTeammates500JSONResponse{Message: "failed to fetch aggregates"}, nil
+	}
+	minimumMatches := stats.DefaultTeammateMinimumMatches
+	if request.Params.MinimumMatches != nil {
+		minimumMatches = *request.Params.MinimumMatches
+	}
+	teammates, solo := s.StatsService.GetTeammates(aggs, characterID, minimumMatches)
+	if request.Params.Count != nil && len(teammates) > *request.Params.Count {
+		teammates = teammates[:*request.Params.Count]
+	}
+	characterIDs := make([]string, 0, len(teammates))
+	for _, teammate := range teammates {
+		characterIDs = append(characterIDs, teammate.CharacterID)
+	}
+	users, err := s.UserService.GetByCharacterIDs(ctx, characterIDs)
+	if err != nil {
+		log.Warn().Err(err).Strs("characterIds", characterIDs).Msg("failed to fetch users by character ids")
+	}
+	for i, teammate := range teammates {
+		u, ok := users[teammate.CharacterID]
+		if !ok {
+			continue
+		}
+		teammates[i].UserID = &u.ID
+		teammates[i].DisplayName = &u.DisplayName
+	}
+	return api.GetTeammates200JSONResponse{Items: teammates, Solo: solo}, nil
+}
+
+func (s Server) GetStreaks(ctx context.Context, request api.GetStreaksRequestObject) (api.GetStreaksResponseObject, error) {
+	characterID := request.Params.CharacterID
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetStreaks500JSONResponse{Message: err.Error()}, nil
+	}
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch aggregates")
+		return api.GetStreaks500JSONResponse{Message: "failed to fetch aggregates"}, nil
+	}
+	window, threshold := tiltParams(request.Params.TiltWindow, request.Params.TiltThreshold)
+	return api.GetStreaks200JSONResponse{
+		Overall: s.StatsService.GetStreaks(aggs, characterID, nil, window, threshold),
+		Modes:   s.StatsService.GetStreaksByMode(aggs, characterID, window, threshold),
+	}, nil
+}
+
+func (s Server) GetTrialsCards(ctx context.Context, request api.GetTrialsCardsRequestObject) (api.GetTrialsCardsResponseObject, error) {
+	characterID := request.Params.CharacterID
+	l := log.With().Str("characterID", characterID).Logger()
+	filter, err := s.statsFilter(ctx, ptr.Of(api.GameModeTrials), request.Params.Filter)
+	if err != nil {
+		return api.GetTrialsCards500JSONResponse{Message: err.Error()}, nil
+	}
+	// Cards are built from every Trials match in the period, so the rest of the filter only picks the cards with a
+	// matching match instead of splitting them
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter.Period())
+	if err != nil {
+		l.Error().Err(err).Msg("failed to fetch aggregates")
+		return api.GetTrialsCards500JSONResponse{Message: "failed to fetch aggregates"}, nil
+	}
+	var keep func(api.Aggregate) bool
+	if !filter.ByPeriodOnly() {
+		matching, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to fetch aggregates")
+			return api.GetTrialsCards500JSONResponse{Message: "failed to fetch aggregates"}, nil
+		}
+		ids := make(map[string]bool, len(matching))
+		for _, agg := range matching {
+			ids[agg.ID] = true
+		}
+		keep = func(agg api.Aggregate) bool {
+			return ids[agg.ID]
+		}
+	}
+	count := stats.DefaultTrialsCardCount
+	if request.Params.Count != nil {
+		count = *request.Params.Count
+	}
+	result := s.StatsService.GetTrialsCards(aggs, characterID, keep, time.Now(), count)
+
+	snapshotIDs := make([]string, 0, len(result.FlawlessLoadouts)+len(result.FailedLoadouts))
+	for _, loadout := range slices.Concat(result.FlawlessLoadouts, result.FailedLoadouts) {
+		if !slices.Contains(snapshotIDs, loadout.SnapshotID) {
+			snapshotIDs = append(snapshotIDs, loadout.SnapshotID)
+		}
+	}
+	if len(snapshotIDs) > 0 {
+		snapshots, err := s.SnapshotService.GetByIDs(ctx, snapshotIDs)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to fetch snapshots")
+			return api.GetTrialsCards500JSONResponse{Message: "failed to fetch snapshots"}, nil
+		}
+		names := make(map[string]string, len(snapshots))
+		for _, snap := range snapshots {
+			names[snap.ID] = snap.Name
+		}
+		for _, loadouts := range [][]api.TrialsCardLoadout{result.FlawlessLoadouts, result.FailedLoadouts} {
+			for i, loadout := range loadouts {
+				if name, ok := names[loadout.SnapshotID]; ok {
+					loadouts[i].Name = &name
+				}
+			}
+		}
+	}
+	return api.GetTrialsCards200JSONResponse(result), nil
+}
+
+func (s Server) GetClassStatAnalysis(ctx context.Context, request api.GetClassStatAnalysisRequestObject) (api.GetClassStatAnalysisResponseObject, error) {
+	characterID := request.Params.CharacterID
+	l := log.With().Str("characterID", characterID).Logger()
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetClassStatAnalysis500JSONResponse{Message: err.Error()}, nil
+	}
+	_, snapshotRollups, err := s.filteredRollups(ctx, characterID, filter)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to fetch rollups")
+		return api.GetClassStatAnalysis500JSONResponse{Message: "failed to fetch rollups"}, nil
+	}
+	snapshots, err := s.rollupSnapshots(ctx, snapshotRollups)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to fetch snapshots")
+		return api.GetClassStatAnalysis500JSONResponse{Message: "failed to fetch snapshots"}, nil
+	}
+	minimumMatches := stats.DefaultClassStatMinimumMatches
+	if request.Params.MinimumMatches != nil {
+		minimumMatches = *request.Params.MinimumMatches
+	}
+	return api.GetClassStatAnalysis200JSONResponse{
+		Items: s.StatsService.GetClassStatAnalysis(snapshots, snapshotRollups, filter.ActivityHashes, minimumMatches),
+	}, nil
+}
+
+func (s Server) GetWeaponTypePerformance(ctx context.Context, request api.GetWeaponTypePerformanceRequestObject) (api.GetWeaponTypePerformanceResponseObject, error) {
+	characterID := request.Params.CharacterID
+	l := log.With().Str("characterID", characterID).Logger()
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetWeaponTypePerformance500JSONResponse{Message: err.Error()}, nil
+	}
+	character, snapshotRollups, err := s.filteredRollups(ctx, characterID, filter)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to fetch rollups")
+		return api.GetWeaponTypePerformance500JSONResponse{Message: "failed to fetch rollups"}, nil
+	}
+	snapshots, err := s.rollupSnapshots(ctx, snapshotRollups)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to fetch snapshots")
+		return api.GetWeaponTypePerformance500JSONResponse{Message: "failed to fetch snapshots"}, nil
+	}
+	result := s.StatsService.GetWeaponTypePerformance(*character, snapshots, filter.ActivityHashes)
+	return api.GetWeaponTypePerformance200JSONResponse(result), nil
+}
+
+func (s Server) GetAbilityKills(ctx context.Context, request api.GetAbilityKillsRequestObject) (api.GetAbilityKillsResponseObject, error) {
+	characterID := request.Params.CharacterID
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetAbilityKills500JSONResponse{Message: err.Error()}, nil
+	}
+	character, snapshotRollups, err := s.filteredRollups(ctx, characterID, filter)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch rollups")
+		return api.GetAbilityKills500JSONResponse{Message: "failed to fetch rollups"}, nil
+	}
+	overall, snapshots := s.StatsService.GetAbilityKillsFromRollups(*character, snapshotRollups, filter.ActivityHashes)
+	return api.GetAbilityKills200JSONResponse{
+		Character: overall,
+		Snapshots: snapshots,
+	}, nil
+}
+
+func (s Server) GetSchedule(ctx context.Context, request api.GetScheduleRequestObject) (api.GetScheduleResponseObject, error) {
+	characterID := request.Params.CharacterID
+	location := time.UTC
+	if request.Params.Timezone != nil && *request.Params.Timezone != "" {
+		loc, err := time.LoadLocation(*request.Params.Timezone)
+		if err != nil {
+			return api.GetSchedule400JSONResponse{Message: "unknown timezone"}, nil
+		}
+		location = loc
+	}
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
+	if err != nil {
+		return api.GetSchedule500JSONResponse{Message: err.Error()}, nil
+	}
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+	if err != nil {
+		log.Error().Err(err).Str("characterID", characterID).Msg("failed to fetch aggregates")
+		return api.GetSchedule500JSONResponse{Message: "failed to fetch aggregates"}, nil
+	}
+	return api.GetSchedule200JSONResponse(s.StatsService.GetSchedule(aggs, characterID, location)), nil
+}
+
+// rollupSnapshots fetches the snapshots of the snapshot rollups.
+func (s Server) rollupSnapshots(ctx context.Context, rollups []api.StatsRollup) ([]api.CharacterSnapshot, error) {
+	snapshotIDs := make([]string, 0, len(rollups))
+	for _, r := range rollups {
+		if r.SnapshotID != nil {
+			snapshotIDs = append(snapshotIDs, *r.SnapshotID)
+		}
+	}
+	if len(snapshotIDs) == 0 {
+		return []api.CharacterSnapshot{}, nil
+	}
+	return s.SnapshotService.GetByIDs(ctx, snapshotIDs)
+}
+
+// tiltParams returns the tilt window and threshold, falling back to the defaults when not given.
+func tiltParams(window *int, threshold *float64) (int, float64) {
+	w, t := stats.DefaultTiltWindow, stats.DefaultTiltThreshold
+	if window != nil {
+		w = *window
+	}
+	if threshold != nil {
+		t = *threshold
+	}
+	return w, t
+}
+
+// rollups syncs the character's rollups with any new aggregates and returns the character and snapshot rollups.
+// Characters whose rollups haven't been built yet, or are stale, get them computed in memory until the rebuild job
+// stores them.
+func (s Server) rollups(ctx context.Context, characterID string) (*api.StatsRollup, []api.StatsRollup, error) {
+	character, err := s.RollupService.Sync(ctx, characterID)
+	if errors.Is(err, rollup.NotFound) || errors.Is(err, rollup.Stale) {
+		aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, stats.Filter{})
+		if err != nil {
+			return nil, nil, err
+		}
+		character, snapshotRollups := rollup.Build(aggs, characterID)
+		return character, snapshotRollups, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	snapshotRollups, err := s.RollupService.GetSnapshots(ctx, characterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return character, snapshotRollups, nil
+}
+
+// statsFilter builds the filter of a metrics request from its game mode and shared filter.
+func (s Server) statsFilter(ctx context.Context, gameMode *api.GameMode, filter *api.StatsFilter) (stats.Filter, error) {
+	activities, err := s.D2Service.GetGameModeActivities(ctx, gameMode)
+	if err != nil {
+		return stats.Filter{}, err
+	}
+	return stats.NewFilter(activities, filter), nil
+}
+
+// filteredRollups returns the character and snapshot rollups of the matches within the filter. The stored rollups
+// are used when the filter only narrows down by game mode, otherwise they're built from the matching aggregates.
+func (s Server) filteredRollups(ctx context.Context, characterID string, filter stats.Filter) (*api.StatsRollup, []api.StatsRollup, error) {
+	if filter.ByModeOnly() {
+		return s.rollups(ctx, characterID)
+	}
+	aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	character, snapshotRollups := rollup.Build(aggs, characterID)
+	return character, snapshotRollups, nil
+}
+
 func (s Server) GetFireteam(ctx context.Context, request api.GetFireteamRequestObject) (api.GetFireteamResponseObject, error) {
 	members, err := s.UserService.GetFireteam(ctx, request.Params.XUserID)
 	if err != nil {
@@ -259,6 +792,284 @@ func (s Server) GetFireteam(ctx context.Context, request api.GetFireteamRequestO
 	return api.GetFireteam200JSONResponse(members), nil
 }
 
+func (s Server) GetGroups(ctx context.Context, request api.GetGroupsRequestObject) (api.GetGroupsResponseObject, error) {
+	getGroups := s.GroupService.GetAllByMember
+	if request.Params.Invited != nil && *request.Params.Invited {
+		getGroups = s.GroupService.GetAllByInvited
+	}
+	groups, err := getGroups(ctx, request.Params.XUserID)
+	if err != nil {
+		log.Error().Err(err).Str("userID", request.Params.XUserID).Msg("failed to fetch groups")
+		return api.GetGroups500JSONResponse{Message: "failed to fetch groups"}, nil
+	}
+	return api.GetGroups200JSONResponse(groups), nil
+}
+
+func (s Server) CreateGroup(ctx context.Context, request api.CreateGroupRequestObject) (api.CreateGroupResponseObject, error) {
+	if request.Body == nil || strings.TrimSpace(request.Body.Name) == "" {
+		return api.CreateGroup400JSONResponse{Message: "name is required"}, nil
+	}
+	var invitedIDs []string
+	if request.Body.MemberIDs != nil {
+		invitedIDs = *request.Body.MemberIDs
+	}
+	g, err := s.GroupService.Create(ctx, request.Params.XUserID, request.Body.Name, invitedIDs)
+	if err != nil {
+		log.Error().Err(err).Str("userID", request.Params.XUserID).Msg("failed to create group")
+		return api.CreateGroup500JSONResponse{Message: "failed to create group"}, nil
+	}
+	return api.CreateGroup201JSONResponse(*g), nil
+}
+
+func (s Server) UpdateGroup(ctx context.Context, request api.UpdateGroupRequestObject) (api.UpdateGroupResponseObject, error) {
+	if request.Body == nil {
+		return api.UpdateGroup400JSONResponse{Message: "body is required"}, nil
+	}
+	if request.Body.Name != nil && strings.TrimSpace(*request.Body.Name) == "" {
+		return api.UpdateGroup400JSONResponse{Message: "name cannot be empty"}, nil
+	}
+	g, err := s.GroupService.Get(ctx, request.GroupID)
+	if err != nil {
+		if errors.Is(err, group.NotFound) {
+			return api.UpdateGroup404JSONResponse{Message: "group not found"}, nil
+		}
+		return api.UpdateGroup500JSONResponse{Message: err.Error()}, nil
+	}
+	if g.OwnerID != request.Params.XUserID {
+		return api.UpdateGroup401JSONResponse{Message: "unauthorized"}, nil
+	}
+	g, err = s.GroupService.Update(ctx, request.GroupID, request.Body.Name, request.Body.MemberIDs)
+	if err != nil {
+		return api.UpdateGroup500JSONResponse{Message: err.Error()}, nil
+	}
+	return api.UpdateGroup200JSONResponse(*g), nil
+}
+
+// RespondToGroupInvitation accepts or declines the user's invitation, users only join a group once they accept.
+func (s Server) RespondToGroupInvitation(ctx context.Context, request api.RespondToGroupInvitationRequestObject) (api.RespondToGroupInvitationResponseObject, error) {
+	accept := request.Body != nil && request.Body.Accept
+	g, err := s.GroupService.Respond(ctx, request.GroupID, request.Params.XUserID, accept)
+	if err != nil {
+		if errors.Is(err, group.NotFound) {
+			return api.RespondToGroupInvitation404JSONResponse{Message: "group not found"}, nil
+		}
+		if errors.Is(err, group.NotInvited) {
+			return api.RespondToGroupInvitation404JSONResponse{Message: "invitation not found"}, nil
+		}
+		log.Error().Err(err).Str("groupID", request.GroupID).Msg("failed to respond to group invitation")
+		return api.RespondToGroupInvitation500JSONResponse{Message: "failed to respond to invitation"}, nil
+	}
+	return api.RespondToGroupInvitation200JSONResponse(*g), nil
+}
+
+func (s Server) DeleteGroup(ctx context.Context, request api.DeleteGroupRequestObject) (api.DeleteGroupResponseObject, error) {
+	g, err := s.GroupService.Get(ctx, request.GroupID)
+	if err != nil {
+		if errors.Is(err, group.NotFound) {
+			return api.DeleteGroup404JSONResponse{Message: "group not found"}, nil
+		}
+		return api.DeleteGroup500JSONResponse{Message: err.Error()}, nil
+	}
+	if g.OwnerID != request.Params.XUserID {
+		return api.DeleteGroup401JSONResponse{Message: "unauthorized"}, nil
+	}
+	if err := s.GroupService.Delete(ctx, request.GroupID); err != nil {
+		return api.DeleteGroup500JSONResponse{Message: err.Error()}, nil
+	}
+	return api.DeleteGroup200JSONResponse(*g), nil
+}
+
+func (s Server) GetLeaderboard(ctx context.Context, request api.GetLeaderboardRequestObject) (api.GetLeaderboardResponseObject, error) {
+	params := request.Params
+	userID := params.XUserID
+	l := log.With().Str("userID", userID).Str("scope", string(params.Scope)).Logger()
+
+	metric := api.LeaderboardMetricKd
+	if params.Metric != nil {
+		metric = *params.Metric
+	}
+	var weaponHash int64
+	if metric == api.LeaderboardMetricWeaponKills {
+		if params.WeaponHash == nil {
+			return api.GetLeaderboard400JSONResponse{Message: "weaponHash is required to rank weapon kills"}, nil
+		}
+		weaponHash = *params.WeaponHash
+	}
+	to := time.Now()
+	if params.To != nil {
+		to = *params.To
+	}
+	from := to.Add(-DefaultLeaderboardWindow)
+	if params.From != nil {
+		from = *params.From
+	}
+	if !from.Before(to) {
+		return api.GetLeaderboard400JSONResponse{Message: "from must be before to"}, nil
+	}
+	minimumMatches := stats.DefaultLeaderboardMinimumMatches
+	if params.MinimumMatches != nil {
+		minimumMatches = *params.MinimumMatches
+	}
+	filter := stats.Filter{}.WithPeriod(&from, &to)
+	if metric == api.LeaderboardMetricTrialsWins {
+		activities, err := s.D2Service.GetGameModeActivities(ctx, ptr.Of(api.GameModeTrials))
+		if err != nil {
+			return api.GetLeaderboard500JSONResponse{Message: err.Error()}, nil
+		}
+		filter = stats.NewFilter(activities, nil).WithPeriod(&from, &to)
+	}
+
+	var userIDs []string
+	switch params.Scope {
+	case api.LeaderboardScopeGroup:
+		if params.GroupID == nil {
+			return api.GetLeaderboard400JSONResponse{Message: "groupId is required for the group scope"}, nil
+		}
+		g, err := s.GroupService.Get(ctx, *params.GroupID)
+		if err != nil {
+			if errors.Is(err, group.NotFound) {
+				return api.GetLeaderboard404JSONResponse{Message: "group not found"}, nil
+			}
+			l.Error().Err(err).Msg("failed to fetch group")
+			return api.GetLeaderboard500JSONResponse{Message: "failed to fetch group"}, nil
+		}
+		if !slices.Contains(g.MemberIDs, userID) {
+			return api.GetLeaderboard401JSONResponse{Message: "unauthorized"}, nil
+		}
+		userIDs = g.MemberIDs
+	case api.LeaderboardScopeFriends:
+		groups, err := s.GroupService.GetAllByMember(ctx, userID)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to fetch groups")
+			return api.GetLeaderboard500JSONResponse{Message: "failed to fetch groups"}, nil
+		}
+		userIDs = []string{userID}
+		for _, g := range groups {
+			for _, id := range g.MemberIDs {
+				if !slices.Contains(userIDs, id) {
+					userIDs = append(userIDs, id)
+				}
+			}
+		}
+	case api.LeaderboardScopeFireteam:
+		ids, err := s.fireteamUserIDs(ctx, userID, filter)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to resolve fireteam")
+			return api.GetLeaderboard500JSONResponse{Message: "failed to resolve fireteam"}, nil
+		}
+		userIDs = ids
+	default:
+		return api.GetLeaderboard400JSONResponse{Message: "unknown scope"}, nil
+	}
+
+	users, err := s.UserService.GetByIDs(ctx, userIDs)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to fetch users")
+		return api.GetLeaderboard500JSONResponse{Message: "failed to fetch leaderboard members"}, nil
+	}
+	members := make([]stats.LeaderboardMember, 0, len(users))
+	for _, u := range users {
+		member, err := s.leaderboardMember(ctx, u, filter)
+		if err != nil {
+			l.Error().Err(err).Str("memberID", u.ID).Msg("failed to fetch leaderboard member")
+			return api.GetLeaderboard500JSONResponse{Message: "failed to fetch leaderboard members"}, nil
+		}
+		members = append(members, *member)
+	}
+	return api.GetLeaderboard200JSONResponse{
+		Scope:  params.Scope,
+		Metric: metric,
+		From:   from,
+		To:     to,
+		Items:  s.StatsService.GetLeaderboard(members, metric, weaponHash, minimumMatches),
+	}, nil
+}
+
+// GetMetaReport returns the cached community meta report of the game mode and window.
+func (s Server) GetMetaReport(ctx context.Context, request api.GetMetaReportRequestObject) (api.GetMetaReportResponseObject, error) {
+	gameMode := api.GameModeAll
+	if request.Params.GameMode != nil {
+		gameMode = *request.Params.GameMode
+	}
+	window := api.MetaWindowWeek
+	if request.Params.Window != nil {
+		window = *request.Params.Window
+	}
+	result, err := s.MetaService.Get(ctx, gameMode, window)
+	if err != nil {
+		if errors.Is(err, meta.NotFound) {
+			return api.GetMetaReport404JSONResponse{Message: "meta report not generated yet"}, nil
+		}
+		log.Error().Err(err).Str("gameMode", string(gameMode)).Str("window", string(window)).Msg("failed to get meta report")
+		return api.GetMetaReport500JSONResponse{Message: "failed to get meta report"}, nil
+	}
+	return api.GetMetaReport200JSONResponse(*result), nil
+}
+
+// fireteamUserIDs returns the user, the OneTrick users in their current fireteam and the ones they frequently
+// queued with in the filter's matches.
+func (s Server) fireteamUserIDs(ctx context.Context, userID string, filter stats.Filter) ([]string, error) {
+	results := []string{userID}
+	addUser := func(id string) {
+		if id != "" && !slices.Contains(results, id) {
+			results = append(results, id)
+		}
+	}
+	party, err := s.UserService.GetFireteam(ctx, userID)
+	if err != nil {
+		// The live fireteam is a bonus, frequent partners are still known when Destiny is down
+		log.Warn().Err(err).Str("userID", userID).Msg("failed to fetch current fireteam")
+	}
+	for _, member := range party {
+		addUser(member.ID)
+	}
+
+	u, err := s.UserService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	partnerIDs := make([]string, 0)
+	for _, characterID := range u.CharacterIDs {
+		aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+		if err != nil {
+			return nil, err
+		}
+		teammates, _ := s.StatsService.GetTeammates(aggs, characterID, stats.DefaultTeammateMinimumMatches)
+		for _, teammate := range teammates {
+			if !slices.Contains(partnerIDs, teammate.CharacterID) {
+				partnerIDs = append(partnerIDs, teammate.CharacterID)
+			}
+		}
+	}
+	partners, err := s.UserService.GetByCharacterIDs(ctx, partnerIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, characterID := range partnerIDs {
+		if partner, ok := partners[characterID]; ok {
+			addUser(partner.ID)
+		}
+	}
+	return results, nil
+}
+
+// leaderboardMember fetches the user's matches within the filter for every one of their characters.
+func (s Server) leaderboardMember(ctx context.Context, u user.User, filter stats.Filter) (*stats.LeaderboardMember, error) {
+	member := &stats.LeaderboardMember{
+		UserID:      u.ID,
+		DisplayName: u.DisplayName,
+		Aggregates:  make(map[string][]api.Aggregate, len(u.CharacterIDs)),
+	}
+	for _, characterID := range u.CharacterIDs {
+		aggs, err := s.StatsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+		if err != nil {
+			return nil, err
+		}
+		member.Aggregates[characterID] = aggs
+	}
+	return member, nil
+}
+
 func (s Server) GetSession(ctx context.Context, request api.GetSessionRequestObject) (api.GetSessionResponseObject, error) {
 	sessionID := request.SessionId
 	l := log.With().Str("sessionID", sessionID).Logger()
@@ -366,11 +1177,38 @@ func (s Server) GetSessionAggregates(ctx context.Context, request api.GetSession
 		l.Error("No aggregate IDs found")
 		return nil, fmt.Errorf("no aggregate found")
 	}
-	aggregates, err := s.AggregateService.GetAggregates(ctx, ses.AggregateIDs)
+	aggregates, snapshotByID, err := s.sessionAggregates(ctx, ses)
 	if err != nil {
-		l.With("error", err.Error()).Error("Failed to fetch aggregates")
+		l.With("error", err.Error()).Error("Failed to fetch session aggregates")
 		return nil, err
 	}
+	// The baseline is read as stored, a session view doesn't sync or build the rollups
+	character, err := s.RollupService.GetCharacter(ctx, ses.CharacterID)
+	if err != nil && !errors.Is(err, rollup.NotFound) {
+		l.With("error", err.Error()).Warn("Failed to fetch rollups, measuring tilt against the session")
+	}
+	window, threshold := tiltParams(request.Params.TiltWindow, request.Params.TiltThreshold)
+	streaks := s.StatsService.GetStreaks(aggregates, ses.CharacterID, character, window, threshold)
+	abilities := s.StatsService.GetAbilityKills(aggregates, ses.CharacterID)
+	return api.GetSessionAggregates200JSONResponse{
+		Aggregates: aggregates,
+		Snapshots:  snapshotByID,
+		Streaks:    &streaks,
+		Abilities:  &abilities,
+	}, nil
+}
+
+// sessionAggregates fetches the aggregates for a session along with the snapshots the session's character
+// used in them, keyed by snapshot ID.
+func (s Server) sessionAggregates(ctx context.Context, ses *api.Session) ([]api.Aggregate, map[string]api.CharacterSnapshot, error) {
+	snapshotByID := make(map[string]api.CharacterSnapshot)
+	if len(ses.AggregateIDs) == 0 {
+		return []api.Aggregate{}, snapshotByID, nil
+	}
+	aggregates, err := s.AggregateService.GetAggregates(ctx, ses.AggregateIDs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch aggregates: %w", err)
+	}
 	uniqueIDS := make([]string, 0)
 	for _, a := range aggregates {
 		link, ok := a.SnapshotLinks[ses.CharacterID]
@@ -382,19 +1220,17 @@ func (s Server) GetSessionAggregates(ctx context.Context, request api.GetSession
 		}
 		uniqueIDS = append(uniqueIDS, *link.SnapshotID)
 	}
+	if len(uniqueIDS) == 0 {
+		return aggregates, snapshotByID, nil
+	}
 	snapshots, err := s.SnapshotService.GetByIDs(ctx, uniqueIDS)
 	if err != nil {
-		l.With("error", err.Error()).Error("Failed to fetch snapshots")
-		return nil, err
+		return nil, nil, fmt.Errorf("failed to fetch snapshots: %w", err)
 	}
-	snapshotByID := make(map[string]api.CharacterSnapshot)
 	for _, snap := range snapshots {
 		snapshotByID[snap.ID] = snap
 	}
-	return api.GetSessionAggregates200JSONResponse{
-		Aggregates: aggregates,
-		Snapshots:  snapshotByID,
-	}, nil
+	return aggregates, snapshotByID, nil
 }
 
 func (s Server) GetSnapshot(ctx context.Context, request api.GetSnapshotRequestObject) (api.GetSnapshotResponseObject, error) {
@@ -404,6 +1240,13 @@ func (s Server) GetSnapshot(ctx context.Context, request api.GetSnapshotRequestO
 		slog.With("error", err.Error()).Error("Failed to fetch snapshot")
 		return nil, fmt.Errorf("failed to fetch snapshot: %w", err)
 	}
+	r, err := s.RollupService.GetSnapshot(ctx, request.SnapshotID)
+	if err != nil && !errors.Is(err, rollup.NotFound) {
+		return nil, fmt.Errorf("failed to fetch snapshot rollup: %w", err)
+	}
+	if r != nil {
+		result.Rating = r.Rating
+	}
 
 	return api.GetSnapshot200JSONResponse(*result), nil
 }
@@ -548,6 +1391,15 @@ func (s Server) UpdateSnapshot(ctx context.Context, request api.UpdateSnapshotRe
 		if request.Body.Description != nil && *request.Body.Description != "" {
 			data["description"] = request.Body.Description
 		}
+		if request.Body.Favorite != nil {
+			data["favorite"] = *request.Body.Favorite
+		}
+		if request.Body.Archived != nil {
+			data["archived"] = *request.Body.Archived
+		}
+		if request.Body.Tags != nil {
+			data["tags"] = snapshot.NormalizeTags(*request.Body.Tags)
+		}
 		return nil
 	})
 	if err != nil {
@@ -569,11 +1421,11 @@ func (s Server) GetSnapshotAggregates(ctx context.Context, request api.GetSnapsh
 		return nil, err
 	}
 
-	gameModeFilter, err := s.D2Service.GetActivityModesFromGameMode(request.Params.GameMode)
+	filter, err := s.statsFilter(ctx, request.Params.GameMode, request.Params.Filter)
 	if err != nil {
 		return nil, err
 	}
-	aggs, err := s.StatsService.GetAggregatesForSnapshot(ctx, snap.ID, gameModeFilter)
+	aggs, err := s.StatsService.GetAggregatesForSnapshot(ctx, snap.CharacterID, snap.ID, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -582,10 +1434,74 @@ func (s Server) GetSnapshotAggregates(ctx context.Context, request api.GetSnapsh
 }
 
 func (s Server) GetSnapshots(ctx context.Context, request api.GetSnapshotsRequestObject) (api.GetSnapshotsResponseObject, error) {
-	snapshots, err := s.SnapshotService.GetAllByCharacter(ctx, request.Params.XUserID, request.Params.CharacterID)
+	params := request.Params
+	filter := snapshot.Filter{
+		Favorite:   params.Favorite,
+		Archived:   params.Archived,
+		WeaponHash: params.WeaponHash,
+	}
+	if params.Search != nil {
+		filter.Search = *params.Search
+	}
+	if params.Tag != nil {
+		filter.Tag = *params.Tag
+	}
+	if params.DamageType != nil {
+		filter.DamageType = *params.DamageType
+	}
+	snapshots, err := s.SnapshotService.Find(ctx, params.XUserID, params.CharacterID, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch snapshots: %w", err)
 	}
+
+	_, snapshotRollups, err := s.rollups(ctx, params.CharacterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rollups: %w", err)
+	}
+	ratings := make(map[string]*api.LoadoutRating, len(snapshotRollups))
+	for _, r := range snapshotRollups {
+		if r.SnapshotID != nil {
+			ratings[*r.SnapshotID] = r.Rating
+		}
+	}
+	for i := range snapshots {
+		snapshots[i].Rating = ratings[snapshots[i].ID]
+	}
+
+	sortBy := api.SnapshotSortCreatedAt
+	if params.Sort != nil {
+		sortBy = *params.Sort
+	}
+	switch sortBy {
+	case api.SnapshotSortLastUsed:
+		slices.SortStableFunc(snapshots, func(a, b api.CharacterSnapshot) int {
+			return b.UpdatedAt.Compare(a.UpdatedAt)
+		})
+	case api.SnapshotSortPerformance:
+		performance, _ := s.StatsService.GetPerformanceFromRollups(snapshotRollups, nil)
+		// Snapshots without any games are placed last
+		kd := func(id string) float64 {
+			p, ok := performance[id]
+			if !ok || p.Kd == nil || p.Kd.Value == nil {
+				return -1
+			}
+			return *p.Kd.Value
+		}
+		slices.SortStableFunc(snapshots, func(a, b api.CharacterSnapshot) int {
+			return cmp.Compare(kd(b.ID), kd(a.ID))
+		})
+	case api.SnapshotSortRating:
+		// Snapshots without a rating are placed last
+		conservative := func(snap api.CharacterSnapshot) float64 {
+			if snap.Rating == nil {
+				return math.Inf(-1)
+			}
+			return snap.Rating.Conservative
+		}
+		slices.SortStableFunc(snapshots, func(a, b api.CharacterSnapshot) int {
+			return cmp.Compare(conservative(b), conservative(a))
+		})
+	}
 	return api.GetSnapshots200JSONResponse(snapshots), nil
 }
 
@@ -598,7 +1514,12 @@ func (s Server) CreateSnapshot(ctx context.Context, request api.CreateSnapshotRe
 		Str("membershipID", membershipID).
 		Str("characterID", characterID).Logger()
 
-	data, err := s.SnapshotService.Save(ctx, userID, membershipID, characterID)
+	nameStyle := api.SnapshotNameStyleMeme
+	if request.Body.NameStyle != nil {
+		nameStyle = *request.Body.NameStyle
+	}
+
+	data, err := s.SnapshotService.Save(ctx, userID, membershipID, characterID, nameStyle)
 	if err != nil {
 		l.Error().Err(err).Msg("couldn't save the users snapshot data")
 		return nil, fmt.Errorf("failed to save snapshot: %w", err)
@@ -706,6 +1627,16 @@ func (s Server) GetActivity(ctx context.Context, request api.GetActivityRequestO
 			return api.GetActivity500JSONResponse{Message: err.Error()}, nil
 		}
 	}
+	// Aggregates are written by the ingest worker, the post game report is only read here so the lobby strength
+	// is stored the first time the activity is viewed
+	if agg != nil && agg.LobbyStrength == nil && activityDetails.Entries != nil {
+		if strength := destiny.LobbyStrength(*activityDetails.Entries); strength != nil {
+			if err := s.AggregateService.SetLobbyStrength(ctx, agg.ID, *strength); err != nil {
+				l.Warn().Err(err).Msg("failed to set lobby strength")
+			}
+			agg.LobbyStrength = strength
+		}
+	}
 
 	entries := make([]map[string]any, 0)
 	for _, entry := range *activityDetails.Entries {
@@ -803,6 +1734,100 @@ func (s Server) BackfillAllUsersCharacterIds(ctx context.Context, request api.Ba
 	}, nil
 }
 
+// RebuildRollups regenerates the stats rollups of one character, or every character of every user. With missingOnly
+// only the characters without rollups or with stale ones are rebuilt and the others are synced, which is how the
+// scheduled run keeps rebuilds off requests.
+func (s Server) RebuildRollups(ctx context.Context, request api.RebuildRollupsRequestObject) (api.RebuildRollupsResponseObject, error) {
+	characterIDs := make([]string, 0)
+	if request.Params.CharacterID != nil {
+		characterIDs = append(characterIDs, *request.Params.CharacterID)
+	} else {
+		users, err := s.UserService.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			characterIDs = append(characterIDs, u.CharacterIDs...)
+		}
+	}
+	missingOnly := request.Params.MissingOnly != nil && *request.Params.MissingOnly
+	var updated int32
+	var failed int32
+	for _, characterID := range characterIDs {
+		if missingOnly {
+			_, err := s.RollupService.Sync(ctx, characterID)
+			if err == nil {
+				continue
+			}
+			if !errors.Is(err, rollup.NotFound) && !errors.Is(err, rollup.Stale) {
+				log.Warn().Err(err).Str("characterID", characterID).Msg("failed to sync rollups")
+				failed++
+				continue
+			}
+		}
+		if err := s.RollupService.Rebuild(ctx, characterID); err != nil {
+			log.Warn().Err(err).Str("characterID", characterID).Msg("failed to rebuild rollups")
+			failed++
+			continue
+		}
+		updated++
+	}
+	return api.RebuildRollups200JSONResponse{
+		Updated: updated,
+		Failed:  failed,
+	}, nil
+}
+
+// GenerateMetaReports rebuilds the cached community meta reports, called on a schedule.
+func (s Server) GenerateMetaReports(ctx context.Context, request api.GenerateMetaReportsRequestObject) (api.GenerateMetaReportsResponseObject, error) {
+	modes := make(map[api.GameMode][]int64)
+	for _, gameMode := range []api.GameMode{api.GameModeAll, api.GameModeQuickPlay, api.GameModeCompetitive, api.GameModeTrials, api.GameModeIronBanner} {
+		activities, err := s.D2Service.GetGameModeActivities(ctx, &gameMode)
+		if err != nil {
+			log.Error().Err(err).Str("gameMode", string(gameMode)).Msg("failed to get activity modes")
+			return api.GenerateMetaReports500JSONResponse{Message: "failed to get activity modes"}, nil
+		}
+		modes[gameMode] = activities.Hashes
+	}
+	reports, err := s.MetaService.Generate(ctx, modes, time.Now())
+	if err != nil {
+		log.Error().Err(err).Msg("failed to generate meta reports")
+		return api.GenerateMetaReports500JSONResponse{Message: "failed to generate meta reports"}, nil
+	}
+	return api.GenerateMetaReports200JSONResponse{Generated: int32(len(reports))}, nil
+}
+
+// GenerateDigests generates the digest of the last complete week for one user, or every user, called weekly.
+func (s Server) GenerateDigests(ctx context.Context, request api.GenerateDigestsRequestObject) (api.GenerateDigestsResponseObject, error) {
+	userIDs := make([]string, 0)
+	if request.Params.UserID != nil {
+		userIDs = append(userIDs, *request.Params.UserID)
+	} else {
+		users, err := s.UserService.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			userIDs = append(userIDs, u.ID)
+		}
+	}
+	weekStart := digest.LastWeekStart(time.Now())
+	var generated int32
+	var failed int32
+	for _, userID := range userIDs {
+		if _, err := s.DigestService.Generate(ctx, userID, weekStart); err != nil {
+			log.Warn().Err(err).Str("userID", userID).Msg("failed to generate digest")
+			failed++
+			continue
+		}
+		generated++
+	}
+	return api.GenerateDigests200JSONResponse{
+		Generated: generated,
+		Failed:    failed,
+	}, nil
+}
+
 func (s Server) BackfillAggregateData(ctx context.Context, request api.BackfillAggregateDataRequestObject) (api.BackfillAggregateDataResponseObject, error) {
 	count, err := s.AggregateService.UpdateAllAggregates(ctx)
 	if err != nil {
@@ -814,3 +1839,150 @@ func (s Server) BackfillAggregateData(ctx context.Context, request api.BackfillA
 		Failed:  0,
 	}, nil
 }
+
+// BackfillLobbyStrength estimates the lobby strength of every aggregate that doesn't have one yet.
+func (s Server) BackfillLobbyStrength(ctx context.Context, request api.BackfillLobbyStrengthRequestObject) (api.BackfillLobbyStrengthResponseObject, error) {
+	aggs, err := s.AggregateService.GetAllAggregates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var updated int32
+	var failed int32
+	for _, agg := range aggs {
+		if agg.LobbyStrength != nil {
+			continue
+		}
+		l := log.With().Str("aggregateID", agg.ID).Str("activityID", agg.ActivityID).Logger()
+		data, _, err := s.D2Service.GetActivity(ctx, agg.ActivityID)
+		if err != nil || data == nil || data.Entries == nil {
+			l.Warn().Err(err).Msg("failed to fetch post game report")
+			failed++
+			continue
+		}
+		strength := destiny.LobbyStrength(*data.Entries)
+		if strength == nil {
+			continue
+		}
+		if err := s.AggregateService.SetLobbyStrength(ctx, agg.ID, *strength); err != nil {
+			l.Warn().Err(err).Msg("failed to set lobby strength")
+			failed++
+			continue
+		}
+		updated++
+	}
+	return api.BackfillLobbyStrength200JSONResponse{
+		Updated: updated,
+		Failed:  failed,
+	}, nil
+}
+
+func (s Server) CreateShareLink(ctx context.Context, request api.CreateShareLinkRequestObject) (api.CreateShareLinkResponseObject, error) {
+	if request.Body == nil || request.Body.ResourceID == "" {
+		return api.CreateShareLink400JSONResponse{Message: "resourceId is required"}, nil
+	}
+	userID := request.Params.XUserID
+	body := request.Body
+	if body.ExpiresAt != nil && body.ExpiresAt.Before(time.Now()) {
+		return api.CreateShareLink400JSONResponse{Message: "expiresAt must be in the future"}, nil
+	}
+
+	l := log.With().Str("userID", userID).Str("resourceID", body.ResourceID).Logger()
+	switch body.Type {
+	case api.ShareTypeSnapshot:
+		snap, err := s.SnapshotService.Get(ctx, body.ResourceID)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to fetch snapshot")
+			return api.CreateShareLink404JSONResponse{Message: "snapshot not found"}, nil
+		}
+		if snap.UserID != userID {
+			return api.CreateShareLink401JSONResponse{Message: "unauthorized"}, nil
+		}
+	case api.ShareTypeSession:
+		ses, err := s.SessionService.Get(ctx, body.ResourceID)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to fetch session")
+			return api.CreateShareLink404JSONResponse{Message: "session not found"}, nil
+		}
+		if ses.UserID != userID {
+			return api.CreateShareLink401JSONResponse{Message: "unauthorized"}, nil
+		}
+	default:
+		return api.CreateShareLink400JSONResponse{Message: "unknown share type"}, nil
+	}
+
+	link, err := s.ShareService.Create(ctx, userID, body.Type, body.ResourceID, body.ExpiresAt)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to create share link")
+		return api.CreateShareLink500JSONResponse{Message: "failed to create share link"}, nil
+	}
+	return api.CreateShareLink201JSONResponse(*link), nil
+}
+
+func (s Server) GetShareLinks(ctx context.Context, request api.GetShareLinksRequestObject) (api.GetShareLinksResponseObject, error) {
+	links, err := s.ShareService.GetAllByUser(ctx, request.Params.XUserID, request.Params.ResourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch share links: %w", err)
+	}
+	return api.GetShareLinks200JSONResponse(links), nil
+}
+
+func (s Server) RevokeShareLink(ctx context.Context, request api.RevokeShareLinkRequestObject) (api.RevokeShareLinkResponseObject, error) {
+	link, err := s.ShareService.Get(ctx, request.Token)
+	if err != nil {
+		if errors.Is(err, share.NotFound) {
+			return api.RevokeShareLink404JSONResponse{Message: "share link not found"}, nil
+		}
+		return api.RevokeShareLink500JSONResponse{Message: err.Error()}, nil
+	}
+	if link.UserID != request.Params.XUserID {
+		return api.RevokeShareLink401JSONResponse{Message: "unauthorized"}, nil
+	}
+	link, err = s.ShareService.Revoke(ctx, request.Token)
+	if err != nil {
+		return api.RevokeShareLink500JSONResponse{Message: err.Error()}, nil
+	}
+	return api.RevokeShareLink200JSONResponse(*link), nil
+}
+
+func (s Server) GetSharedContent(ctx context.Context, request api.GetSharedContentRequestObject) (api.GetSharedContentResponseObject, error) {
+	link, err := s.ShareService.Resolve(ctx, request.Token)
+	if err != nil {
+		if errors.Is(err, share.NotFound) || errors.Is(err, share.Revoked) || errors.Is(err, share.Expired) {
+			return api.GetSharedContent404JSONResponse{Message: "share link not found"}, nil
+		}
+		return api.GetSharedContent500JSONResponse{Message: err.Error()}, nil
+	}
+
+	l := log.With().Str("resourceID", link.ResourceID).Logger()
+	switch link.Type {
+	case api.ShareTypeSnapshot:
+		snap, err := s.SnapshotService.Get(ctx, link.ResourceID)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to fetch shared snapshot")
+			return api.GetSharedContent404JSONResponse{Message: "snapshot not found"}, nil
+		}
+		return api.GetSharedContent200JSONResponse{
+			Type:     link.Type,
+			Snapshot: snap,
+		}, nil
+	case api.ShareTypeSession:
+		ses, err := s.SessionService.Get(ctx, link.ResourceID)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to fetch shared session")
+			return api.GetSharedContent404JSONResponse{Message: "session not found"}, nil
+		}
+		aggregates, snapshots, err := s.sessionAggregates(ctx, ses)
+		if err != nil {
+			l.Error().Err(err).Msg("failed to fetch shared session aggregates")
+			return api.GetSharedContent500JSONResponse{Message: "failed to fetch session aggregates"}, nil
+		}
+		return api.GetSharedContent200JSONResponse{
+			Type:       link.Type,
+			Session:    ses,
+			Aggregates: &aggregates,
+			Snapshots:  &snapshots,
+		}, nil
+	default:
+		return api.GetSharedContent400JSONResponse{Message: "unknown share type"}, nil
+	}
+}
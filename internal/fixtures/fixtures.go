@@ -0,0 +1,38 @@
+// Package fixtures builds the aggregate parts the services' tests are made of.
+package fixtures
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"strconv"
+)
+
+// Value returns a stat holding v.
+func Value(v float64) *api.StatsValuePair {
+	return &api.StatsValuePair{Value: &v}
+}
+
+// PlayerStats returns the stats of a match with the kills, deaths and result. Zero standing is a win in D2.
+func PlayerStats(kills, deaths float64, won bool) api.PlayerStats {
+	standing := 1.0
+	if won {
+		standing = 0
+	}
+	return api.PlayerStats{Kills: Value(kills), Deaths: Value(deaths), Standing: Value(standing)}
+}
+
+// Weapons returns the weapons of a match keyed by item hash, each named after its hash and with the kills.
+func Weapons(kills float64, hashes ...int64) map[string]api.WeaponInstanceMetrics {
+	results := make(map[string]api.WeaponInstanceMetrics, len(hashes))
+	for _, hash := range hashes {
+		key := strconv.FormatInt(hash, 10)
+		results[key] = api.WeaponInstanceMetrics{
+			ReferenceID: &hash,
+			Display:     &api.Display{Name: "Weapon " + key},
+			Stats: &map[string]api.UniqueStatValue{
+				destiny.WeaponKillsStat: {Basic: *Value(kills)},
+			},
+		}
+	}
+	return results
+}
@@ -10,12 +10,19 @@ import (
 	"oneTrick/envvars"
 	"oneTrick/services/aggregate"
 	"oneTrick/services/destiny"
+	"oneTrick/services/digest"
+	"oneTrick/services/group"
+	"oneTrick/services/meta"
+	"oneTrick/services/rollup"
 	"oneTrick/services/session"
+	"oneTrick/services/share"
 	"oneTrick/services/snapshot"
 	"oneTrick/services/stats"
 	"oneTrick/services/user"
 	"oneTrick/validator"
 	"os"
+	// Embeds the timezone database, the production image doesn't ship one
+	_ "time/tzdata"
 
 	"github.com/algolia/algoliasearch-client-go/v4/algolia/search"
 	"github.com/getkin/kin-openapi/openapi3filter"
@@ -76,8 +83,13 @@ func main() {
 	userService := user.NewUserService(firestore, destinyService, searchClient)
 	aggregateService := aggregate.NewService(firestore)
 	sessionService := session.NewService(firestore)
-	snapshotService := snapshot.NewService(firestore, userService, destinyService, aggregateService)
+	rollupService := rollup.NewService(firestore)
+	snapshotService := snapshot.NewService(firestore, userService, destinyService, aggregateService, rollupService)
 	statsService := stats.NewService(firestore, snapshotService)
+	shareService := share.NewService(firestore)
+	groupService := group.NewService(firestore)
+	metaService := meta.NewService(firestore, manifestService)
+	digestService := digest.NewService(firestore, userService, sessionService, snapshotService, statsService)
 	server := NewServer(
 		destinyService,
 		d2AuthAService,
@@ -87,6 +99,11 @@ func main() {
 		sessionService,
 		manifestService,
 		statsService,
+		shareService,
+		rollupService,
+		groupService,
+		metaService,
+		digestService,
 	)
 
 	defer firestore.Close()
@@ -20,16 +20,22 @@ type Service interface {
 	// GetAggregate retrieves an existing aggregate for a given activity ID.
 	GetAggregate(ctx context.Context, activityID string) (*api.Aggregate, error)
 
-	// GetAggregates retrieves a list of aggregates for the given session IDs.
-	// Limited to max of 30 documents at once
+	// GetAggregates retrieves a list of aggregates for the given aggregate IDs, sorted by creation time.
 	GetAggregates(ctx context.Context, IDs []string) ([]api.Aggregate, error)
 
-	BySnapshotID(ctx context.Context, snapshotID string, gameModeFilter []string) ([]api.Aggregate, error)
+	// BySnapshotID returns the aggregates linked to the snapshot.
+	BySnapshotID(ctx context.Context, snapshotID string) ([]api.Aggregate, error)
 
 	UpdateAllAggregates(ctx context.Context) (int, error)
 
-	// GetAggregatesByActivity retrieves a list of aggregates for the given activity IDs.
-	// Limited to a max of 30 documents at once
+	// GetAllAggregates returns every aggregate. Used for admin backfills.
+	GetAllAggregates(ctx context.Context) ([]api.Aggregate, error)
+
+	// SetLobbyStrength stores the lobby strength estimate of an aggregate.
+	SetLobbyStrength(ctx context.Context, aggregateID string, strength api.LobbyStrength) error
+
+	// GetAggregatesByActivity retrieves a list of aggregates for the given activity IDs, following the order
+	// of activityIDs. Activities without an aggregate are skipped.
 	GetAggregatesByActivity(ctx context.Context, activityIDs []string) ([]api.Aggregate, error)
 
 	// Update allows for updating an aggregate document's data.
@@ -80,7 +86,7 @@ func (s *service) Update(ctx context.Context, aggregateID string, updateFn func(
 	return err
 }
 
-func (s *service) BySnapshotID(ctx context.Context, snapshotID string, gameModeFilter []string) ([]api.Aggregate, error) {
+func (s *service) BySnapshotID(ctx context.Context, snapshotID string) ([]api.Aggregate, error) {
 	if snapshotID == "" {
 		return nil, fmt.Errorf("snapshotID is required")
 	}
@@ -88,10 +94,6 @@ func (s *service) BySnapshotID(ctx context.Context, snapshotID string, gameModeF
 	q := s.DB.Collection(collection).
 		Where("snapshotIds", "array-contains", snapshotID)
 
-	if len(gameModeFilter) > 0 {
-		q = q.Where("activityHistory.mode", "in", gameModeFilter)
-	}
-
 	docs, err := q.Documents(ctx).GetAll()
 
 	if err != nil {
@@ -129,55 +131,36 @@ func (s *service) GetAggregate(ctx context.Context, activityID string) (*api.Agg
 }
 
 func (s *service) GetAggregatesByActivity(ctx context.Context, activityIDs []string) ([]api.Aggregate, error) {
-	docs, err := s.DB.
-		Collection(collection).
-		Where("activityId", "in", activityIDs).
-		Documents(ctx).GetAll()
+	results, missing, err := utils.GetByFieldIn[api.Aggregate](
+		ctx,
+		s.DB.Collection(collection).Query,
+		"activityId",
+		activityIDs,
+		func(agg api.Aggregate) string {
+			return agg.ActivityID
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
-	results, err := utils.GetAllToStructs[api.Aggregate](docs)
-	if err != nil {
-		return nil, err
+	if len(missing) > 0 {
+		log.Warn().Strs("activityIds", missing).Msg("activities without an aggregate")
 	}
 	return results, nil
 }
 
 func (s *service) GetAggregates(ctx context.Context, IDs []string) ([]api.Aggregate, error) {
-	if len(IDs) == 0 {
-		return []api.Aggregate{}, nil
+	results, missing, err := utils.GetByIDs[api.Aggregate](ctx, s.DB, collection, IDs)
+	if err != nil {
+		return nil, err
 	}
-
-	var allResults []api.Aggregate
-	batchSize := 30
-
-	// Process IDs in batches of 30
-	for i := 0; i < len(IDs); i += batchSize {
-		end := i + batchSize
-		if end > len(IDs) {
-			end = len(IDs)
-		}
-		batch := IDs[i:end]
-
-		docs, err := s.DB.
-			Collection(collection).
-			Where("id", "in", batch).
-			Documents(ctx).GetAll()
-		if err != nil {
-			return nil, err
-		}
-
-		results, err := utils.GetAllToStructs[api.Aggregate](docs)
-		if err != nil {
-			return nil, err
-		}
-
-		allResults = append(allResults, results...)
+	if len(missing) > 0 {
+		log.Warn().Strs("aggregateIds", missing).Msg("aggregates not found")
 	}
-	sort.Slice(allResults, func(i, j int) bool {
-		return allResults[i].CreatedAt.Before(allResults[j].CreatedAt)
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].CreatedAt.Before(results[j].CreatedAt)
 	})
-	return allResults, nil
+	return results, nil
 }
 
 func (s *service) GetAllAggregates(ctx context.Context) ([]api.Aggregate, error) {
@@ -228,6 +211,13 @@ func (s *service) UpdateAllAggregates(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+func (s *service) SetLobbyStrength(ctx context.Context, aggregateID string, strength api.LobbyStrength) error {
+	_, err := s.DB.Collection(collection).Doc(aggregateID).Set(ctx, map[string]any{
+		"lobbyStrength": strength,
+	}, firestore.MergeAll)
+	return err
+}
+
 // Helper function to convert any slice to []interface{}
 func toInterfaceSlice[T any](slice []T) []interface{} {
 	result := make([]interface{}, len(slice))
@@ -81,11 +81,15 @@ func generateBaseInfo(item *bungie.DestinyItem, items map[string]ItemDefinition,
 	icon := items[hash].DisplayProperties.Icon
 
 	base := api.BaseItemInfo{
-		BucketHash: int64(*c.BucketHash),
-		InstanceId: *c.ItemInstanceId,
-		ItemHash:   int64(*c.ItemHash),
-		Name:       name,
-		Icon:       ptr.Of(setBaseBungieURL(&icon)),
+		BucketHash:                 int64(*c.BucketHash),
+		InstanceId:                 *c.ItemInstanceId,
+		ItemHash:                   int64(*c.ItemHash),
+		Name:                       name,
+		Icon:                       ptr.Of(setBaseBungieURL(&icon)),
+		ItemTypeDisplayName:        items[hash].ItemTypeDisplayName,
+		ItemTypeAndTierDisplayName: items[hash].ItemTypeAndTierDisplayName,
+		TierType:                   int32(items[hash].Inventory.TierType),
+		TierTypeName:               items[hash].Inventory.TierTypeName,
 	}
 
 	if item.Instance != nil {
@@ -370,10 +374,36 @@ func CarnageEntryToInstancePerformance(entry *bungie.PostGameCarnageReportEntry,
 
 	result.Extra = BungieStatValueToUniqueStatValue(entry.Extended.Values)
 	result.PlayerStats = *ToPlayerStats(entry.Values)
+	result.PlayerStats = WithAbilityKills(*result)
 	result.Weapons = WeaponsToInstanceWeapons(entry.Extended.Weapons, items)
 	return result
 }
 
+// WithAbilityKills returns the player stats with the ability kills filled in from the extended values of the
+// performance. Aggregates stored before ability kills were extracted only have them in Extra.
+func WithAbilityKills(performance api.InstancePerformance) api.PlayerStats {
+	stats := performance.PlayerStats
+	if performance.Extra == nil {
+		return stats
+	}
+	extra := *performance.Extra
+	for key, field := range map[string]**api.StatsValuePair{
+		GrenadeKillsStat: &stats.GrenadeKills,
+		MeleeKillsStat:   &stats.MeleeKills,
+		SuperKillsStat:   &stats.SuperKills,
+		AbilityKillsStat: &stats.AbilityKills,
+	} {
+		if *field != nil {
+			continue
+		}
+		if value, ok := extra[key]; ok {
+			pair := value.Basic
+			*field = &pair
+		}
+	}
+	return stats
+}
+
 func BungieStatValueToUniqueStatValue(values *map[string]bungie.HistoricalStatsValue) *map[string]api.UniqueStatValue {
 	if values == nil {
 		return nil
@@ -487,20 +517,22 @@ func ActivityModeTypeToString(modeType *bungie.CurrentActivityModeType) string {
 	}
 }
 
-func gameModeToActivityModes(gameMode api.GameMode) ([]string, error) {
+// gameModeToModeType returns the activity mode type every playlist of the game mode is tagged with in the manifest,
+// or nil for every mode. Mode types stay the same across seasons, unlike the playlists' names.
+func gameModeToModeType(gameMode api.GameMode) (*bungie.CurrentActivityModeType, error) {
 	switch gameMode {
 	case api.GameModeAll:
 		return nil, nil
 	case api.GameModeCompetitive:
-		return []string{"Competitive: Matchmade", "Competitive"}, nil
+		return ptr.Of(bungie.CurrentActivityModeTypePvPCompetitive), nil
 	case api.GameModeQuickPlay:
-		return []string{"Control Quickplay", "Control", "Control: Matchmade", "Clash", "Clash Quickplay"}, nil
+		return ptr.Of(bungie.CurrentActivityModeTypePvPQuickplay), nil
 	case api.GameModeIronBanner:
-		return []string{"Iron Banner Zone:Control", "Iron Banner:Control", "Iron Banner", "Iron Banner:Supremacy", "Iron Banner:Rift", "Iron Banner:Clash"}, nil
+		return ptr.Of(bungie.CurrentActivityModeTypeIronBanner), nil
 	case api.GameModeTrials:
-		return []string{"Trials of Osiris", "Trials of Osiris: Matchmade"}, nil
+		return ptr.Of(bungie.CurrentActivityModeTypeTrialsOfOsiris), nil
 	default:
-		return nil, nil
+		return nil, fmt.Errorf("unknown game mode %q", gameMode)
 	}
 }
 
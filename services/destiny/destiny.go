@@ -31,7 +31,9 @@ type Service interface {
 	GetPerformances(ctx context.Context, activityID string, characterIDs []string) (map[string]api.InstancePerformance, error)
 	GetEnrichedActivity(ctx context.Context, activityID string, characterIDs []string) (*EnrichedActivity, error)
 	Search(ctx context.Context, prefix string, page int32) ([]api.SearchUserResult, bool, error)
-	GetActivityModesFromGameMode(gameMode *api.GameMode) ([]string, error)
+	// GetGameModeActivities resolves the game mode to the playlists it's played in. A nil game mode, or every
+	// mode, returns no playlists. Returns an error for unknown game modes.
+	GetGameModeActivities(ctx context.Context, gameMode *api.GameMode) (GameModeActivities, error)
 }
 
 type service struct {
@@ -233,6 +235,7 @@ func (a *service) GetEnrichedActivity(ctx context.Context, activityID string, ch
 		Performances:    performances,
 		Teams:           TransformTeams(data.Teams),
 		PostGameEntries: *data.Entries,
+		LobbyStrength:   LobbyStrength(*data.Entries),
 	}
 	return &result, nil
 }
@@ -553,9 +556,25 @@ func (a *service) GetActivity(ctx context.Context, activityID string) (*bungie.P
 	return data, TransformTeams(data.Teams), nil
 }
 
-func (a *service) GetActivityModesFromGameMode(gameMode *api.GameMode) ([]string, error) {
+func (a *service) GetGameModeActivities(ctx context.Context, gameMode *api.GameMode) (GameModeActivities, error) {
 	if gameMode == nil {
-		return nil, nil
+		return GameModeActivities{}, nil
+	}
+	modeType, err := gameModeToModeType(*gameMode)
+	if err != nil || modeType == nil {
+		return GameModeActivities{}, err
+	}
+	definitions, err := a.ManifestService.GetActivitiesByModeType(ctx, int(*modeType))
+	if err != nil {
+		return GameModeActivities{}, fmt.Errorf("failed to get activities of game mode %s: %w", *gameMode, err)
+	}
+	if len(definitions) == 0 {
+		// No playlists would otherwise read as every mode
+		return GameModeActivities{}, fmt.Errorf("no activities found for game mode %s", *gameMode)
+	}
+	result := GameModeActivities{}
+	for _, d := range definitions {
+		result.Hashes = append(result.Hashes, int64(d.Hash))
 	}
-	return gameModeToActivityModes(*gameMode)
+	return result, nil
 }
@@ -0,0 +1,166 @@
+package destiny
+
+import (
+	"oneTrick/api"
+	"oneTrick/clients/bungie"
+	"strconv"
+)
+
+// teamStrength is the running total of the players on one team.
+type teamStrength struct {
+	Players    int
+	Efficiency float64
+	Score      float64
+}
+
+func (t teamStrength) toAPI() api.TeamStrength {
+	if t.Players == 0 {
+		return api.TeamStrength{}
+	}
+	return api.TeamStrength{
+		Players:    t.Players,
+		Efficiency: t.Efficiency / float64(t.Players),
+		Score:      t.Score / float64(t.Players),
+	}
+}
+
+// LobbyStrength estimates how strong a match's lobby was from the post game entries of every player.
+// Returns nil when no entry has stats.
+func LobbyStrength(entries []bungie.PostGameCarnageReportEntry) *api.LobbyStrength {
+	var lobby teamStrength
+	teams := make(map[string]teamStrength)
+	for _, entry := range entries {
+		if entry.Values == nil {
+			continue
+		}
+		efficiency := entryEfficiency(*entry.Values)
+		score := entryValue(*entry.Values, "score")
+		lobby.Players++
+		lobby.Efficiency += efficiency
+		lobby.Score += score
+		if team, ok := (*entry.Values)["team"]; ok && team.Basic != nil && team.Basic.Value != nil {
+			id := strconv.FormatInt(int64(*team.Basic.Value), 10)
+			t := teams[id]
+			t.Players++
+			t.Efficiency += efficiency
+			t.Score += score
+			teams[id] = t
+		}
+	}
+	if lobby.Players == 0 {
+		return nil
+	}
+	total := lobby.toAPI()
+	result := &api.LobbyStrength{
+		Players:    total.Players,
+		Efficiency: total.Efficiency,
+		Score:      total.Score,
+		Teams:      make(map[string]api.TeamStrength, len(teams)),
+	}
+	for id, t := range teams {
+		result.Teams[id] = t.toAPI()
+	}
+	return result
+}
+
+// entryEfficiency returns the player's (kills + assists) / deaths, computing it when Bungie didn't report it.
+func entryEfficiency(values map[string]bungie.HistoricalStatsValue) float64 {
+	if _, ok := values["efficiency"]; ok {
+		return entryValue(values, "efficiency")
+	}
+	kills := entryValue(values, "kills")
+	assists := entryValue(values, "assists")
+	deaths := entryValue(values, "deaths")
+	if deaths == 0 {
+		return kills + assists
+	}
+	return (kills + assists) / deaths
+}
+
+func entryValue(values map[string]bungie.HistoricalStatsValue, key string) float64 {
+	value, ok := values[key]
+	if !ok || value.Basic == nil || value.Basic.Value == nil {
+		return 0
+	}
+	return *value.Basic.Value
+}
+
+// OpponentStrength returns the average efficiency of the character's opponents in the match, and false
+// when the aggregate has no lobby estimate. Opponents are the players on every other team, or the rest of
+// the lobby in modes without teams.
+func OpponentStrength(agg api.Aggregate, characterID string) (float64, bool) {
+	lobby := agg.LobbyStrength
+	if lobby == nil || lobby.Players == 0 {
+		return 0, false
+	}
+	performance, ok := agg.Performance[characterID]
+	if !ok {
+		return lobby.Efficiency, true
+	}
+	stats := performance.PlayerStats
+	if team, ok := playerTeam(stats); ok && len(lobby.Teams) > 1 {
+		players, efficiency := 0, 0.0
+		for id, t := range lobby.Teams {
+			if id == team {
+				continue
+			}
+			players += t.Players
+			efficiency += t.Efficiency * float64(t.Players)
+		}
+		if players > 0 {
+			return efficiency / float64(players), true
+		}
+	}
+	if lobby.Players < 2 {
+		return lobby.Efficiency, true
+	}
+	// Without teams, take the character out of the lobby average
+	total := lobby.Efficiency*float64(lobby.Players) - playerEfficiency(stats)
+	return total / float64(lobby.Players-1), true
+}
+
+// TeamStrength returns the average efficiency of the character's own team, the character included, and
+// false when the aggregate has no lobby estimate or the mode has no teams.
+func TeamStrength(agg api.Aggregate, characterID string) (float64, bool) {
+	if agg.LobbyStrength == nil {
+		return 0, false
+	}
+	performance, ok := agg.Performance[characterID]
+	if !ok {
+		return 0, false
+	}
+	team, ok := playerTeam(performance.PlayerStats)
+	if !ok {
+		return 0, false
+	}
+	t, ok := agg.LobbyStrength.Teams[team]
+	if !ok || t.Players == 0 {
+		return 0, false
+	}
+	return t.Efficiency, true
+}
+
+// playerTeam returns the ID of the team the player was on, keyed the same as LobbyStrength teams.
+func playerTeam(stats api.PlayerStats) (string, bool) {
+	if stats.Team == nil || stats.Team.Value == nil {
+		return "", false
+	}
+	return strconv.FormatInt(int64(*stats.Team.Value), 10), true
+}
+
+func playerEfficiency(stats api.PlayerStats) float64 {
+	var kills, assists, deaths float64
+	if stats.Kills != nil && stats.Kills.Value != nil {
+		kills = *stats.Kills.Value
+	}
+	if stats.Assists != nil && stats.Assists.Value != nil {
+		assists = *stats.Assists.Value
+	}
+	if stats.Deaths != nil && stats.Deaths.Value != nil {
+		deaths = *stats.Deaths.Value
+	}
+	if deaths == 0 {
+		return kills + assists
+	}
+	return (kills + assists) / deaths
+}
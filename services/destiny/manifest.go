@@ -20,6 +20,9 @@ type ManifestService interface {
 	// Returns a map with hash values as keys and ActivityDefinition structs as values
 	GetActivities(ctx context.Context) (map[string]ActivityDefinition, error)
 
+	// GetActivitiesByModeType retrieves the activity definitions tagged with the activity mode type
+	GetActivitiesByModeType(ctx context.Context, modeType int) ([]ActivityDefinition, error)
+
 	// GetClasses retrieves all class definitions from the manifest
 	// Returns a map with hash values as keys and ClassDefinition structs as values
 	GetClasses(ctx context.Context) (map[string]ClassDefinition, error)
@@ -137,6 +140,17 @@ func (m *manifestService) GetActivities(ctx context.Context) (map[string]Activit
 	})
 }
 
+// GetActivitiesByModeType retrieves the activity definitions tagged with the activity mode type
+func (m *manifestService) GetActivitiesByModeType(ctx context.Context, modeType int) ([]ActivityDefinition, error) {
+	docs, err := m.db.Collection(string(ActivityCollection)).
+		Where("activityModeTypes", "array-contains", modeType).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return utils.GetAllToStructs[ActivityDefinition](docs)
+}
+
 func (m *manifestService) GetClasses(ctx context.Context) (map[string]ClassDefinition, error) {
 	docs, err := m.db.Collection(string(ClassCollection)).Documents(ctx).GetAll()
 	if err != nil {
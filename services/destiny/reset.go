@@ -0,0 +1,14 @@
+package destiny
+
+import "time"
+
+// WeeklyReset returns the last weekly reset, Tuesday at 17:00 UTC, at or before t.
+func WeeklyReset(t time.Time) time.Time {
+	t = t.UTC()
+	reset := time.Date(t.Year(), t.Month(), t.Day(), 17, 0, 0, 0, time.UTC)
+	reset = reset.AddDate(0, 0, -((int(reset.Weekday()) - int(time.Tuesday) + 7) % 7))
+	if reset.After(t) {
+		reset = reset.AddDate(0, 0, -7)
+	}
+	return reset
+}
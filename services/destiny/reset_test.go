@@ -0,0 +1,15 @@
+package destiny
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWeeklyReset(t *testing.T) {
+	want := time.Date(2024, 1, 2, 17, 0, 0, 0, time.UTC)
+	for _, at := range []time.Time{want, time.Date(2024, 1, 5, 18, 0, 0, 0, time.UTC), time.Date(2024, 1, 9, 16, 59, 0, 0, time.UTC)} {
+		if got := WeeklyReset(at); !got.Equal(want) {
+			t.Errorf("WeeklyReset(%v) = %v, want %v", at, got, want)
+		}
+	}
+}
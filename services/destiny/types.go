@@ -502,12 +502,20 @@ type ActivityModeDefinition struct {
 	Blacklisted           bool                  `json:"blacklisted" firestore:"blacklisted"`
 }
 
+// GameModeActivities are the playlists a game mode is played in, resolved from the manifest. Empty means every mode.
+type GameModeActivities struct {
+	// Hashes are the playlists' director activity hashes, matched against activityHistory.activityHash and
+	// keying the rollup mode buckets.
+	Hashes []int64
+}
+
 type EnrichedActivity struct {
 	Activity        *api.ActivityHistory                `json:"activity" firestore:"activity"`
 	Performances    map[string]api.InstancePerformance  `json:"performances" firestore:"performances"`
 	Teams           []api.Team                          `json:"teams" firestore:"teams"`
 	Period          *time.Time                          `json:"period" firestore:"period"`
 	PostGameEntries []bungie.PostGameCarnageReportEntry `json:"postGameEntries" firestore:"postGameEntries"`
+	LobbyStrength   *api.LobbyStrength                  `json:"lobbyStrength" firestore:"lobbyStrength"`
 }
 
 type ManifestResponse struct {
@@ -575,6 +583,23 @@ const (
 	ClassArmor     ArmorBucket = 1585787867
 )
 
+// Weapon stat keys reported in the extended values of a PGCR entry.
+const (
+	WeaponKillsStat          = "uniqueWeaponKills"
+	WeaponPrecisionKillsStat = "uniqueWeaponPrecisionKills"
+)
+
+// Ability kill stat keys reported in the extended values of a PGCR entry.
+const (
+	GrenadeKillsStat = "weaponKillsGrenade"
+	MeleeKillsStat   = "weaponKillsMelee"
+	SuperKillsStat   = "weaponKillsSuper"
+	AbilityKillsStat = "weaponKillsAbility"
+)
+
+// PowerLevelStat is the character stat hash of the power level, which is reported alongside the armor stats.
+const PowerLevelStat = "1935470627"
+
 type RequestInfo = int32
 
 const (
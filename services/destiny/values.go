@@ -0,0 +1,36 @@
+package destiny
+
+import "oneTrick/api"
+
+// IsWin reports whether the player won the match. Zero is a win in D2.
+func IsWin(stats api.PlayerStats) bool {
+	return stats.Standing != nil && stats.Standing.Value != nil && *stats.Standing.Value == 0
+}
+
+// PairValue returns the value of a stat pair as an int, or zero when it isn't set.
+func PairValue(pair *api.StatsValuePair) int {
+	if pair == nil || pair.Value == nil {
+		return 0
+	}
+	return int(*pair.Value)
+}
+
+// StatValue returns the basic value of a unique stat, e.g. WeaponKillsStat, or zero when it isn't present.
+func StatValue(stats *map[string]api.UniqueStatValue, key string) int {
+	if stats == nil {
+		return 0
+	}
+	stat, ok := (*stats)[key]
+	if !ok {
+		return 0
+	}
+	return PairValue(&stat.Basic)
+}
+
+// Ratio returns a / b, or zero when b is zero.
+func Ratio(a, b int) float64 {
+	if b == 0 {
+		return 0
+	}
+	return float64(a) / float64(b)
+}
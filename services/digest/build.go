@@ -0,0 +1,214 @@
+package digest
+
+import (
+	"cmp"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+	"strconv"
+	"time"
+)
+
+const (
+	// minimumLoadoutMatches is how many matches a loadout needs in the week to be picked as the best or worst.
+	minimumLoadoutMatches = 3
+
+	goalMatches        = 10
+	goalKD             = 1.0
+	goalWinRate        = 0.5
+	goalMatchesName    = "Play 10 matches"
+	goalKDName         = "Finish the week with a K/D of 1.0"
+	goalWinRateName    = "Win half your matches"
+	goalBeatLastWeekKD = "Beat last week's K/D"
+)
+
+// totals sums up the matches of a loadout, or of the whole week.
+type totals struct {
+	matches int
+	wins    int
+	kills   int
+	deaths  int
+}
+
+// build sums up the matches each character played in the week starting at weekStart and compares them with the
+// week before. aggs must cover the new weapon lookback, names holds the name of each linked snapshot.
+func build(aggs map[string][]api.Aggregate, names map[string]string, weekStart time.Time) api.Digest {
+	weekEnd := weekStart.Add(week)
+	previousStart := weekStart.Add(-week)
+	var current, previous totals
+	loadouts := make(map[string]*totals)
+	earlier := make(map[int64]bool)
+	weapons := make(map[int64]*api.DigestWeapon)
+	for characterID, characterAggs := range aggs {
+		for _, agg := range characterAggs {
+			performance, ok := agg.Performance[characterID]
+			if !ok {
+				continue
+			}
+			period := agg.ActivityDetails.Period
+			if period.Before(weekStart) {
+				for _, weapon := range performance.Weapons {
+					if weapon.ReferenceID != nil {
+						earlier[*weapon.ReferenceID] = true
+					}
+				}
+				if !period.Before(previousStart) {
+					previous.add(performance.PlayerStats)
+				}
+				continue
+			}
+			if !period.Before(weekEnd) {
+				continue
+			}
+			current.add(performance.PlayerStats)
+			if link, ok := agg.SnapshotLinks[characterID]; ok && link.SnapshotID != nil {
+				loadout, ok := loadouts[*link.SnapshotID]
+				if !ok {
+					loadout = &totals{}
+					loadouts[*link.SnapshotID] = loadout
+				}
+				loadout.add(performance.PlayerStats)
+			}
+			for _, weapon := range performance.Weapons {
+				if weapon.ReferenceID == nil {
+					continue
+				}
+				kills := destiny.StatValue(weapon.Stats, destiny.WeaponKillsStat)
+				if kills == 0 {
+					continue
+				}
+				w, ok := weapons[*weapon.ReferenceID]
+				if !ok {
+					w = &api.DigestWeapon{Hash: *weapon.ReferenceID, Name: strconv.FormatInt(*weapon.ReferenceID, 10)}
+					if weapon.Display != nil {
+						w.Name = weapon.Display.Name
+						w.Icon = weapon.Display.Icon
+					}
+					weapons[*weapon.ReferenceID] = w
+				}
+				w.Kills += kills
+				w.Matches++
+			}
+		}
+	}
+
+	result := api.Digest{
+		WeekStart:  weekStart,
+		WeekEnd:    weekEnd,
+		Totals:     current.digest(),
+		Previous:   previous.digest(),
+		NewWeapons: make([]api.DigestWeapon, 0),
+	}
+	result.Trend = api.DigestTrend{
+		Matches: result.Totals.Matches - result.Previous.Matches,
+		Kd:      result.Totals.Kd - result.Previous.Kd,
+		WinRate: result.Totals.WinRate - result.Previous.WinRate,
+	}
+	result.BestLoadout, result.WorstLoadout = bestAndWorstLoadouts(loadouts, names)
+	for hash, weapon := range weapons {
+		if !earlier[hash] {
+			result.NewWeapons = append(result.NewWeapons, *weapon)
+		}
+	}
+	slices.SortFunc(result.NewWeapons, func(a, b api.DigestWeapon) int {
+		if c := cmp.Compare(b.Kills, a.Kills); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	result.Goals = goals(result.Totals, result.Previous)
+	return result
+}
+
+// bestAndWorstLoadouts picks the loadouts with the best and worst K/D among the ones played enough. The worst is
+// left out when a single loadout was played enough.
+func bestAndWorstLoadouts(loadouts map[string]*totals, names map[string]string) (*api.DigestLoadout, *api.DigestLoadout) {
+	eligible := make([]api.DigestLoadout, 0, len(loadouts))
+	for snapshotID, loadout := range loadouts {
+		if loadout.matches < minimumLoadoutMatches {
+			continue
+		}
+		t := loadout.digest()
+		eligible = append(eligible, api.DigestLoadout{
+			SnapshotID: snapshotID,
+			Name:       names[snapshotID],
+			Matches:    t.Matches,
+			Kd:         t.Kd,
+			WinRate:    t.WinRate,
+		})
+	}
+	if len(eligible) == 0 {
+		return nil, nil
+	}
+	slices.SortFunc(eligible, func(a, b api.DigestLoadout) int {
+		if c := cmp.Compare(b.Kd, a.Kd); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SnapshotID, b.SnapshotID)
+	})
+	if len(eligible) == 1 {
+		return &eligible[0], nil
+	}
+	return &eligible[0], &eligible[len(eligible)-1]
+}
+
+// goals checks the week against the weekly goals. Beating last week's K/D only counts when there was a last week.
+func goals(current, previous api.DigestTotals) []api.DigestGoal {
+	played := current.Matches > 0
+	results := []api.DigestGoal{
+		{Name: goalMatchesName, Target: goalMatches, Value: float64(current.Matches), Achieved: current.Matches >= goalMatches},
+		{Name: goalKDName, Target: goalKD, Value: current.Kd, Achieved: played && current.Kd >= goalKD},
+		{Name: goalWinRateName, Target: goalWinRate, Value: current.WinRate, Achieved: played && current.WinRate >= goalWinRate},
+	}
+	if previous.Matches > 0 {
+		results = append(results, api.DigestGoal{
+			Name:     goalBeatLastWeekKD,
+			Target:   previous.Kd,
+			Value:    current.Kd,
+			Achieved: played && current.Kd > previous.Kd,
+		})
+	}
+	return results
+}
+
+func (t *totals) add(stats api.PlayerStats) {
+	t.matches++
+	t.kills += destiny.PairValue(stats.Kills)
+	t.deaths += destiny.PairValue(stats.Deaths)
+	if destiny.IsWin(stats) {
+		t.wins++
+	}
+}
+
+func (t *totals) digest() api.DigestTotals {
+	result := api.DigestTotals{
+		Matches: t.matches,
+		Wins:    t.wins,
+		Kills:   t.kills,
+		Deaths:  t.deaths,
+		Kd:      float64(t.kills),
+	}
+	if t.deaths > 0 {
+		result.Kd = float64(t.kills) / float64(t.deaths)
+	}
+	result.WinRate = destiny.Ratio(t.wins, t.matches)
+	return result
+}
+
+// linkedSnapshotIDs returns the snapshots the characters' matches within from and to are linked to.
+func linkedSnapshotIDs(aggs map[string][]api.Aggregate, from, to time.Time) []string {
+	results := make([]string, 0)
+	for characterID, characterAggs := range aggs {
+		for _, agg := range characterAggs {
+			period := agg.ActivityDetails.Period
+			if period.Before(from) || !period.Before(to) {
+				continue
+			}
+			link, ok := agg.SnapshotLinks[characterID]
+			if ok && link.SnapshotID != nil && !slices.Contains(results, *link.SnapshotID) {
+				results = append(results, *link.SnapshotID)
+			}
+		}
+	}
+	return results
+}
@@ -0,0 +1,92 @@
+package digest
+
+import (
+	"oneTrick/api"
+	"oneTrick/internal/fixtures"
+	"strings"
+	"testing"
+	"time"
+)
+
+func digestMatch(period time.Time, characterID, snapshotID string, kills, deaths float64, won bool, weaponHashes ...int64) api.Aggregate {
+	return api.Aggregate{
+		ActivityDetails: api.ActivityHistory{Period: period},
+		Performance: map[string]api.InstancePerformance{
+			characterID: {
+				PlayerStats: fixtures.PlayerStats(kills, deaths, won),
+				Weapons:     fixtures.Weapons(1, weaponHashes...),
+			},
+		},
+		SnapshotLinks: map[string]api.SnapshotLink{characterID: {SnapshotID: &snapshotID}},
+	}
+}
+
+func TestLastWeekStart(t *testing.T) {
+	// Sunday the 18th falls in the week starting at the reset of Tuesday the 13th, so the last complete week starts
+	// at the reset of the 6th
+	want := time.Date(2026, 10, 6, 17, 0, 0, 0, time.UTC)
+	if got := LastWeekStart(time.Date(2026, 10, 18, 15, 0, 0, 0, time.UTC)); !got.Equal(want) {
+		t.Errorf("LastWeekStart() = %v, want %v", got, want)
+	}
+	if got := LastWeekStart(time.Date(2026, 10, 13, 17, 0, 0, 0, time.UTC)); !got.Equal(want) {
+		t.Errorf("LastWeekStart() at the reset = %v, want %v", got, want)
+	}
+}
+
+func TestBuild(t *testing.T) {
+	weekStart := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	aggs := map[string][]api.Aggregate{
+		"a": {
+			digestMatch(weekStart.Add(day), "a", "s1", 20, 10, true, 1, 2),
+			digestMatch(weekStart.Add(2*day), "a", "s1", 20, 10, true, 1),
+			digestMatch(weekStart.Add(3*day), "a", "s1", 20, 10, false, 1),
+			digestMatch(weekStart.Add(-day), "a", "s1", 5, 10, false, 1),
+			digestMatch(weekStart.Add(-20*day), "a", "s1", 5, 10, false, 3),
+		},
+		"b": {
+			digestMatch(weekStart.Add(day), "b", "s2", 5, 10, false),
+			digestMatch(weekStart.Add(2*day), "b", "s2", 5, 10, false, 3),
+			digestMatch(weekStart.Add(3*day), "b", "s2", 5, 10, true),
+			digestMatch(weekStart.Add(8*day), "b", "s2", 50, 1, true),
+		},
+	}
+	names := map[string]string{"s1": "Hand Cannon", "s2": "Sniper"}
+
+	got := build(aggs, names, weekStart)
+	if got.Totals.Matches != 6 || got.Totals.Wins != 3 || got.Totals.Kills != 75 || got.Totals.Deaths != 60 {
+		t.Fatalf("build() totals = %+v, want 6 matches, 3 wins, 75 kills, 60 deaths", got.Totals)
+	}
+	if got.Previous.Matches != 1 || got.Trend.Matches != 5 || got.Trend.Kd != 1.25-0.5 {
+		t.Errorf("build() previous, trend = %+v, %+v, want one match a K/D of 0.5 ago", got.Previous, got.Trend)
+	}
+	if got.BestLoadout == nil || got.BestLoadout.Name != "Hand Cannon" || got.BestLoadout.Kd != 2 {
+		t.Errorf("build() best loadout = %+v, want Hand Cannon at a K/D of 2", got.BestLoadout)
+	}
+	if got.WorstLoadout == nil || got.WorstLoadout.SnapshotID != "s2" {
+		t.Errorf("build() worst loadout = %+v, want s2", got.WorstLoadout)
+	}
+	if len(got.NewWeapons) != 1 || got.NewWeapons[0].Hash != 2 {
+		t.Errorf("build() new weapons = %+v, want only hash 2", got.NewWeapons)
+	}
+	achieved := make(map[string]bool)
+	for _, goal := range got.Goals {
+		achieved[goal.Name] = goal.Achieved
+	}
+	if len(got.Goals) != 4 || achieved[goalMatchesName] || !achieved[goalKDName] || !achieved[goalWinRateName] || !achieved[goalBeatLastWeekKD] {
+		t.Errorf("build() goals = %+v, want every goal but the matches one achieved", got.Goals)
+	}
+
+	got.DisplayName = "Guardian <3"
+	markdown := renderMarkdown(got)
+	if !strings.Contains(markdown, "6 matches") || !strings.Contains(markdown, "- [x] "+goalKDName) {
+		t.Errorf("renderMarkdown() = %q, want the totals and the achieved goals", markdown)
+	}
+	html, err := renderHTML(got)
+	if err != nil {
+		t.Fatalf("renderHTML() error = %v", err)
+	}
+	if !strings.Contains(html, "<strong>Hand Cannon</strong>") || !strings.Contains(html, "Weapon 2") {
+		t.Errorf("renderHTML() = %q, want the best loadout and the new weapon", html)
+	}
+}
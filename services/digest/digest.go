@@ -0,0 +1,135 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"oneTrick/services/session"
+	"oneTrick/services/snapshot"
+	"oneTrick/services/stats"
+	"oneTrick/services/user"
+	"oneTrick/utils"
+	"time"
+
+	"cloud.google.com/go/firestore"
+)
+
+// Service generates and stores the weekly digest of each user.
+type Service interface {
+	// Generate builds the digest of the user's week starting at weekStart and saves it, replacing the week's
+	// previous digest.
+	Generate(ctx context.Context, userID string, weekStart time.Time) (*api.Digest, error)
+
+	// GetAllByUser returns the user's latest digests, newest first.
+	GetAllByUser(ctx context.Context, userID string, count int) ([]api.Digest, error)
+}
+
+const (
+	collection = "digests"
+	week       = 7 * 24 * time.Hour
+	// newWeaponLookback is how far before the week a weapon must not have been used to count as new.
+	newWeaponLookback = 4 * week
+)
+
+type service struct {
+	db              *firestore.Client
+	userService     user.Service
+	sessionService  session.Service
+	snapshotService snapshot.Service
+	statsService    stats.Service
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(
+	db *firestore.Client,
+	userService user.Service,
+	sessionService session.Service,
+	snapshotService snapshot.Service,
+	statsService stats.Service,
+) Service {
+	return &service{
+		db:              db,
+		userService:     userService,
+		sessionService:  sessionService,
+		snapshotService: snapshotService,
+		statsService:    statsService,
+	}
+}
+
+// LastWeekStart returns the start of the last complete week before now. Weeks run from one Destiny weekly reset to
+// the next, the same weeks Trials cards are played in.
+func LastWeekStart(now time.Time) time.Time {
+	return destiny.WeeklyReset(now).Add(-week)
+}
+
+func (s *service) Generate(ctx context.Context, userID string, weekStart time.Time) (*api.Digest, error) {
+	u, err := s.userService.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	weekEnd := weekStart.Add(week)
+	from := weekStart.Add(-newWeaponLookback)
+	filter := stats.Filter{}.WithPeriod(&from, &weekEnd)
+	aggs := make(map[string][]api.Aggregate, len(u.CharacterIDs))
+	for _, characterID := range u.CharacterIDs {
+		characterAggs, err := s.statsService.GetAggregatesByCharacterID(ctx, characterID, filter)
+		if err != nil {
+			return nil, err
+		}
+		aggs[characterID] = characterAggs
+	}
+	sessions, err := s.sessionService.GetStartedBetween(ctx, userID, weekStart, weekEnd)
+	if err != nil {
+		return nil, err
+	}
+	names, err := s.snapshotNames(ctx, linkedSnapshotIDs(aggs, weekStart, weekEnd))
+	if err != nil {
+		return nil, err
+	}
+
+	result := build(aggs, names, weekStart)
+	result.ID = fmt.Sprintf("%s-%s", u.ID, weekStart.Format(time.DateOnly))
+	result.UserID = u.ID
+	result.DisplayName = u.DisplayName
+	result.Sessions = len(sessions)
+	result.GeneratedAt = time.Now()
+	result.Markdown = renderMarkdown(result)
+	result.HTML, err = renderHTML(result)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.db.Collection(collection).Doc(result.ID).Set(ctx, result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (s *service) GetAllByUser(ctx context.Context, userID string, count int) ([]api.Digest, error) {
+	docs, err := s.db.Collection(collection).
+		Where("userId", "==", userID).
+		OrderBy("weekStart", firestore.Desc).
+		Limit(count).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return utils.GetAllToStructs[api.Digest](docs)
+}
+
+// snapshotNames returns the name of each snapshot, keyed by id.
+func (s *service) snapshotNames(ctx context.Context, snapshotIDs []string) (map[string]string, error) {
+	results := make(map[string]string, len(snapshotIDs))
+	if len(snapshotIDs) == 0 {
+		return results, nil
+	}
+	snapshots, err := s.snapshotService.GetByIDs(ctx, snapshotIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, snap := range snapshots {
+		results[snap.ID] = snap.Name
+	}
+	return results, nil
+}
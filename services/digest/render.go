@@ -0,0 +1,106 @@
+package digest
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"oneTrick/api"
+	"strings"
+	"time"
+)
+
+const dateFormat = "Jan 2"
+
+// renderMarkdown renders the digest as a Markdown body for notification channels.
+func renderMarkdown(d api.Digest) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Your week in OneTrick, %s\n\n", weekRange(d))
+	if d.Totals.Matches == 0 {
+		fmt.Fprintf(&b, "No matches this week, %s. Your loadouts are waiting for you.\n", d.DisplayName)
+		return b.String()
+	}
+	fmt.Fprintf(&b, "%d matches over %d sessions: %d wins, K/D %.2f, win rate %s.\n\n",
+		d.Totals.Matches, d.Sessions, d.Totals.Wins, d.Totals.Kd, percent(d.Totals.WinRate))
+	if d.Previous.Matches > 0 {
+		fmt.Fprintf(&b, "Versus last week: K/D %s, win rate %s, %+d matches.\n\n",
+			signed(d.Trend.Kd), signedPercent(d.Trend.WinRate), d.Trend.Matches)
+	}
+	if d.BestLoadout != nil {
+		b.WriteString("## Loadouts\n\n")
+		fmt.Fprintf(&b, "- Best: **%s**, K/D %.2f over %d matches\n", d.BestLoadout.Name, d.BestLoadout.Kd, d.BestLoadout.Matches)
+		if d.WorstLoadout != nil {
+			fmt.Fprintf(&b, "- Worst: **%s**, K/D %.2f over %d matches\n", d.WorstLoadout.Name, d.WorstLoadout.Kd, d.WorstLoadout.Matches)
+		}
+		b.WriteString("\n")
+	}
+	if len(d.NewWeapons) > 0 {
+		b.WriteString("## New weapons\n\n")
+		for _, weapon := range d.NewWeapons {
+			fmt.Fprintf(&b, "- %s: %d kills in %d matches\n", weapon.Name, weapon.Kills, weapon.Matches)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("## Goals\n\n")
+	for _, goal := range d.Goals {
+		check := " "
+		if goal.Achieved {
+			check = "x"
+		}
+		fmt.Fprintf(&b, "- [%s] %s\n", check, goal.Name)
+	}
+	return b.String()
+}
+
+var htmlTemplate = template.Must(template.New("digest").Funcs(template.FuncMap{
+	"percent":       percent,
+	"signed":        signed,
+	"signedPercent": signedPercent,
+	"kd":            func(kd float64) string { return fmt.Sprintf("%.2f", kd) },
+}).Parse(`<h1>Your week in OneTrick, {{.Range}}</h1>
+{{with .Digest}}{{if eq .Totals.Matches 0}}<p>No matches this week, {{.DisplayName}}. Your loadouts are waiting for you.</p>
+{{else}}<p>{{.Totals.Matches}} matches over {{.Sessions}} sessions: {{.Totals.Wins}} wins, K/D {{kd .Totals.Kd}}, win rate {{percent .Totals.WinRate}}.</p>
+{{if gt .Previous.Matches 0}}<p>Versus last week: K/D {{signed .Trend.Kd}}, win rate {{signedPercent .Trend.WinRate}}, {{printf "%+d" .Trend.Matches}} matches.</p>
+{{end}}{{with .BestLoadout}}<h2>Loadouts</h2>
+<ul>
+<li>Best: <strong>{{.Name}}</strong>, K/D {{kd .Kd}} over {{.Matches}} matches</li>
+{{end}}{{with .WorstLoadout}}<li>Worst: <strong>{{.Name}}</strong>, K/D {{kd .Kd}} over {{.Matches}} matches</li>
+{{end}}{{if .BestLoadout}}</ul>
+{{end}}{{if .NewWeapons}}<h2>New weapons</h2>
+<ul>
+{{range .NewWeapons}}<li>{{.Name}}: {{.Kills}} kills in {{.Matches}} matches</li>
+{{end}}</ul>
+{{end}}<h2>Goals</h2>
+<ul>
+{{range .Goals}}<li>{{if .Achieved}}&#10003;{{else}}&#10007;{{end}} {{.Name}}</li>
+{{end}}</ul>
+{{end}}{{end}}`))
+
+// renderHTML renders the digest as an HTML body for notification channels.
+func renderHTML(d api.Digest) (string, error) {
+	var b bytes.Buffer
+	err := htmlTemplate.Execute(&b, struct {
+		Range  string
+		Digest api.Digest
+	}{Range: weekRange(d), Digest: d})
+	if err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// weekRange formats the digest's week, the end being exclusive.
+func weekRange(d api.Digest) string {
+	return fmt.Sprintf("%s - %s", d.WeekStart.Format(dateFormat), d.WeekEnd.Add(-time.Nanosecond).Format(dateFormat))
+}
+
+func percent(value float64) string {
+	return fmt.Sprintf("%.0f%%", value*100)
+}
+
+func signed(value float64) string {
+	return fmt.Sprintf("%+.2f", value)
+}
+
+func signedPercent(value float64) string {
+	return fmt.Sprintf("%+.0f%%", value*100)
+}
@@ -0,0 +1,208 @@
+package group
+
+import (
+	"context"
+	"fmt"
+	"oneTrick/api"
+	"oneTrick/utils"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Service manages named groups of users that compete on a shared leaderboard.
+type Service interface {
+	// Create saves a new group owned by ownerID. The owner is always a member, the other users are invited and
+	// only join once they accept.
+	Create(ctx context.Context, ownerID, name string, invitedIDs []string) (*api.Group, error)
+
+	// Get returns the group, or NotFound when it doesn't exist.
+	Get(ctx context.Context, groupID string) (*api.Group, error)
+
+	// GetAllByMember returns the groups the user is a member of, sorted by name.
+	GetAllByMember(ctx context.Context, userID string) ([]api.Group, error)
+
+	// GetAllByInvited returns the groups the user is invited to, sorted by name.
+	GetAllByInvited(ctx context.Context, userID string) ([]api.Group, error)
+
+	// Update renames the group and replaces its members. Nil values are left unchanged. Members that aren't listed
+	// are removed and listed users that aren't members yet are invited, the owner is always kept as a member.
+	// The caller is responsible for checking the user owns the group.
+	Update(ctx context.Context, groupID string, name *string, memberIDs *[]string) (*api.Group, error)
+
+	// Respond accepts or declines the user's invitation to the group, returning NotInvited when there's none.
+	Respond(ctx context.Context, groupID, userID string, accept bool) (*api.Group, error)
+
+	// Delete removes the group. The caller is responsible for checking the user owns the group.
+	Delete(ctx context.Context, groupID string) error
+}
+
+const collection = "groups"
+
+type service struct {
+	db *firestore.Client
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(db *firestore.Client) Service {
+	return &service{
+		db: db,
+	}
+}
+
+func (s *service) Create(ctx context.Context, ownerID, name string, invitedIDs []string) (*api.Group, error) {
+	now := time.Now()
+	memberIDs, invited := membership(ownerID, nil, invitedIDs)
+	result := &api.Group{
+		Name:       strings.TrimSpace(name),
+		OwnerID:    ownerID,
+		MemberIDs:  memberIDs,
+		InvitedIDs: &invited,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	ref := s.db.Collection(collection).NewDoc()
+	result.ID = ref.ID
+	if _, err := ref.Set(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *service) Get(ctx context.Context, groupID string) (*api.Group, error) {
+	if groupID == "" {
+		return nil, NotFound
+	}
+	doc, err := s.db.Collection(collection).Doc(groupID).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, NotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := &api.Group{}
+	if err := doc.DataTo(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *service) GetAllByMember(ctx context.Context, userID string) ([]api.Group, error) {
+	return s.getAllContaining(ctx, "memberIds", userID)
+}
+
+func (s *service) GetAllByInvited(ctx context.Context, userID string) ([]api.Group, error) {
+	return s.getAllContaining(ctx, "invitedIds", userID)
+}
+
+// getAllContaining returns the groups with the user in the array field, sorted by name.
+func (s *service) getAllContaining(ctx context.Context, field, userID string) ([]api.Group, error) {
+	docs, err := s.db.Collection(collection).
+		Where(field, "array-contains", userID).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	results, err := utils.GetAllToStructs[api.Group](docs)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(results, func(a, b api.Group) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+	return results, nil
+}
+
+func (s *service) Update(ctx context.Context, groupID string, name *string, memberIDs *[]string) (*api.Group, error) {
+	g, err := s.Get(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	g.UpdatedAt = time.Now()
+	updates := []firestore.Update{{Path: "updatedAt", Value: g.UpdatedAt}}
+	if name != nil {
+		g.Name = strings.TrimSpace(*name)
+		updates = append(updates, firestore.Update{Path: "name", Value: g.Name})
+	}
+	if memberIDs != nil {
+		var invited []string
+		g.MemberIDs, invited = membership(g.OwnerID, g.MemberIDs, *memberIDs)
+		g.InvitedIDs = &invited
+		updates = append(updates,
+			firestore.Update{Path: "memberIds", Value: g.MemberIDs},
+			firestore.Update{Path: "invitedIds", Value: invited},
+		)
+	}
+	if _, err := s.db.Collection(collection).Doc(g.ID).Update(ctx, updates); err != nil {
+		return nil, fmt.Errorf("failed to update group: %w", err)
+	}
+	return g, nil
+}
+
+func (s *service) Respond(ctx context.Context, groupID, userID string, accept bool) (*api.Group, error) {
+	if groupID == "" {
+		return nil, NotFound
+	}
+	ref := s.db.Collection(collection).Doc(groupID)
+	result := &api.Group{}
+	err := s.db.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		doc, err := tx.Get(ref)
+		if status.Code(err) == codes.NotFound {
+			return NotFound
+		}
+		if err != nil {
+			return err
+		}
+		if err := doc.DataTo(result); err != nil {
+			return err
+		}
+		if result.InvitedIDs == nil || !slices.Contains(*result.InvitedIDs, userID) {
+			return NotInvited
+		}
+		invited := slices.DeleteFunc(slices.Clone(*result.InvitedIDs), func(id string) bool { return id == userID })
+		result.InvitedIDs = &invited
+		if accept && !slices.Contains(result.MemberIDs, userID) {
+			result.MemberIDs = append(result.MemberIDs, userID)
+		}
+		result.UpdatedAt = time.Now()
+		return tx.Update(ref, []firestore.Update{
+			{Path: "memberIds", Value: result.MemberIDs},
+			{Path: "invitedIds", Value: invited},
+			{Path: "updatedAt", Value: result.UpdatedAt},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *service) Delete(ctx context.Context, groupID string) error {
+	_, err := s.db.Collection(collection).Doc(groupID).Delete(ctx)
+	return err
+}
+
+// membership splits the requested users into the group's members and invites. Current members that are still
+// requested stay, everyone else requested is invited. The owner is always the first member, and blanks and
+// duplicates are dropped.
+func membership(ownerID string, current, requested []string) ([]string, []string) {
+	memberIDs := []string{ownerID}
+	invited := make([]string, 0)
+	for _, id := range requested {
+		id = strings.TrimSpace(id)
+		if id == "" || slices.Contains(memberIDs, id) || slices.Contains(invited, id) {
+			continue
+		}
+		if slices.Contains(current, id) {
+			memberIDs = append(memberIDs, id)
+			continue
+		}
+		invited = append(invited, id)
+	}
+	return memberIDs, invited
+}
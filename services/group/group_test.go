@@ -0,0 +1,45 @@
+package group
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMembership(t *testing.T) {
+	tests := []struct {
+		name        string
+		current     []string
+		requested   []string
+		wantMembers []string
+		wantInvited []string
+	}{
+		{
+			name:        "new group invites everyone but the owner",
+			requested:   []string{"owner", "a", " b ", "", "a"},
+			wantMembers: []string{"owner"},
+			wantInvited: []string{"a", "b"},
+		},
+		{
+			name:        "members stay and new users are invited",
+			current:     []string{"owner", "a"},
+			requested:   []string{"a", "c"},
+			wantMembers: []string{"owner", "a"},
+			wantInvited: []string{"c"},
+		},
+		{
+			name:        "unlisted members are removed",
+			current:     []string{"owner", "a", "b"},
+			requested:   []string{"b"},
+			wantMembers: []string{"owner", "b"},
+			wantInvited: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			members, invited := membership("owner", tt.current, tt.requested)
+			if !reflect.DeepEqual(members, tt.wantMembers) || !reflect.DeepEqual(invited, tt.wantInvited) {
+				t.Errorf("membership() = %v, %v, want %v, %v", members, invited, tt.wantMembers, tt.wantInvited)
+			}
+		})
+	}
+}
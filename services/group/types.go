@@ -0,0 +1,8 @@
+package group
+
+import "errors"
+
+var (
+	NotFound   = errors.New("group not found")
+	NotInvited = errors.New("user is not invited to the group")
+)
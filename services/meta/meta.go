@@ -0,0 +1,141 @@
+package meta
+
+import (
+	"context"
+	"fmt"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"oneTrick/utils"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Service builds and caches the community meta reports across every OneTrick player.
+type Service interface {
+	// Get returns the cached report for the game mode and window, or NotFound when it hasn't been generated yet.
+	Get(ctx context.Context, gameMode api.GameMode, window api.MetaWindow) (*api.MetaReport, error)
+
+	// Generate rebuilds the report of every game mode and window from the aggregates played up to now, replacing
+	// the cached reports. modes holds the activity hashes of each game mode, where no activities means every mode.
+	Generate(ctx context.Context, modes map[api.GameMode][]int64, now time.Time) ([]api.MetaReport, error)
+}
+
+const (
+	collection            = "metaReports"
+	aggregatesCollection  = "aggregates"
+	defaultTopWeaponCount = 25
+)
+
+// Windows are the windows every game mode's report is generated for, with how far back each one looks.
+var Windows = map[api.MetaWindow]time.Duration{
+	api.MetaWindowDay:   24 * time.Hour,
+	api.MetaWindowWeek:  7 * 24 * time.Hour,
+	api.MetaWindowMonth: 30 * 24 * time.Hour,
+}
+
+type service struct {
+	db              *firestore.Client
+	manifestService destiny.ManifestService
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(db *firestore.Client, manifestService destiny.ManifestService) Service {
+	return &service{
+		db:              db,
+		manifestService: manifestService,
+	}
+}
+
+func (s *service) Get(ctx context.Context, gameMode api.GameMode, window api.MetaWindow) (*api.MetaReport, error) {
+	doc, err := s.db.Collection(collection).Doc(reportID(gameMode, window)).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, NotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := &api.MetaReport{}
+	if err := doc.DataTo(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *service) Generate(ctx context.Context, modes map[api.GameMode][]int64, now time.Time) ([]api.MetaReport, error) {
+	longest := time.Duration(0)
+	for _, d := range Windows {
+		longest = max(longest, d)
+	}
+	docs, err := s.db.Collection(aggregatesCollection).
+		Where("activityHistory.period", ">=", now.Add(-longest)).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	aggs, err := utils.GetAllToStructs[api.Aggregate](docs)
+	if err != nil {
+		return nil, err
+	}
+	items := s.weaponDefinitions(ctx, aggs)
+
+	results := make([]api.MetaReport, 0, len(modes)*len(Windows))
+	bw := s.db.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, len(modes)*len(Windows))
+	for gameMode, activities := range modes {
+		for window, d := range Windows {
+			report := build(aggs, items, activities, now.Add(-d), now, defaultTopWeaponCount)
+			report.ID = reportID(gameMode, window)
+			report.GameMode = gameMode
+			report.Window = window
+			report.GeneratedAt = time.Now()
+			job, err := bw.Set(s.db.Collection(collection).Doc(report.ID), report)
+			if err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, job)
+			results = append(results, report)
+		}
+	}
+	if err := utils.EndBulkWriter(bw, jobs); err != nil {
+		return nil, fmt.Errorf("failed to write meta reports: %w", err)
+	}
+	log.Info().Int("aggregates", len(aggs)).Int("reports", len(results)).Msg("generated meta reports")
+	return results, nil
+}
+
+// weaponDefinitions fetches the manifest definition of every weapon that got a kill in the aggregates, keyed by
+// item hash. Weapons missing from the manifest are skipped.
+func (s *service) weaponDefinitions(ctx context.Context, aggs []api.Aggregate) map[int64]destiny.ItemDefinition {
+	results := make(map[int64]destiny.ItemDefinition)
+	missing := make(map[int64]bool)
+	for _, agg := range aggs {
+		for _, performance := range agg.Performance {
+			for _, weapon := range performance.Weapons {
+				if weapon.ReferenceID == nil {
+					continue
+				}
+				hash := *weapon.ReferenceID
+				if _, ok := results[hash]; ok || missing[hash] {
+					continue
+				}
+				item, err := s.manifestService.GetItem(ctx, hash)
+				if err != nil {
+					log.Warn().Err(err).Int64("hash", hash).Msg("failed to fetch weapon definition")
+					missing[hash] = true
+					continue
+				}
+				results[hash] = *item
+			}
+		}
+	}
+	return results
+}
+
+func reportID(gameMode api.GameMode, window api.MetaWindow) string {
+	return fmt.Sprintf("%s-%s", gameMode, window)
+}
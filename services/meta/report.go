@@ -0,0 +1,166 @@
+package meta
+
+import (
+	"cmp"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+	"time"
+)
+
+const (
+	exoticTierType   = 6
+	exoticTierName   = "Exotic"
+	unknownArchetype = "Unknown"
+)
+
+// usage totals the player matches a weapon, or an archetype, got a kill in.
+type usage struct {
+	hash    *int64
+	name    string
+	icon    *string
+	players map[string]bool
+	matches int
+	kills   int
+	wins    int
+}
+
+// build totals the weapon usage of every OneTrick character in the aggregates played within from and to, in the
+// given activity hashes or every activity when none are given. Weapons and exotics keep the top most used.
+func build(aggs []api.Aggregate, items map[int64]destiny.ItemDefinition, activities []int64, from, to time.Time, top int) api.MetaReport {
+	weapons := make(map[int64]*usage)
+	archetypes := make(map[string]*usage)
+	players := make(map[string]bool)
+	matches, kills := 0, 0
+	for _, agg := range aggs {
+		details := agg.ActivityDetails
+		if details.Period.Before(from) || !details.Period.Before(to) {
+			continue
+		}
+		if len(activities) > 0 && !slices.Contains(activities, details.ActivityHash) {
+			continue
+		}
+		for characterID, performance := range agg.Performance {
+			players[characterID] = true
+			matches++
+			won := destiny.IsWin(performance.PlayerStats)
+			// A player counts once per weapon and archetype in a match, however many instances or weapons got kills
+			weaponKills := make(map[int64]int)
+			archetypeKills := make(map[string]int)
+			for _, weapon := range performance.Weapons {
+				if weapon.ReferenceID == nil {
+					continue
+				}
+				hash := *weapon.ReferenceID
+				k := destiny.StatValue(weapon.Stats, destiny.WeaponKillsStat)
+				kills += k
+				if _, ok := weapons[hash]; !ok {
+					w := newUsage(&hash, weaponName(weapon, items[hash]))
+					if weapon.Display != nil {
+						w.icon = weapon.Display.Icon
+					}
+					weapons[hash] = w
+				}
+				weaponKills[hash] += k
+
+				archetype := items[hash].ItemTypeDisplayName
+				if archetype == "" {
+					archetype = unknownArchetype
+				}
+				archetypeKills[archetype] += k
+			}
+			for hash, k := range weaponKills {
+				weapons[hash].count(characterID, k, won)
+			}
+			for archetype, k := range archetypeKills {
+				a, ok := archetypes[archetype]
+				if !ok {
+					a = newUsage(nil, archetype)
+					archetypes[archetype] = a
+				}
+				a.count(characterID, k, won)
+			}
+		}
+	}
+
+	report := api.MetaReport{
+		From:       from,
+		To:         to,
+		Players:    len(players),
+		Matches:    matches,
+		Weapons:    make([]api.MetaEntry, 0),
+		Exotics:    make([]api.MetaEntry, 0),
+		Archetypes: make([]api.MetaEntry, 0, len(archetypes)),
+	}
+	for hash, w := range weapons {
+		entry := w.entry(matches, kills)
+		report.Weapons = append(report.Weapons, entry)
+		if isExotic(items[hash]) {
+			report.Exotics = append(report.Exotics, entry)
+		}
+	}
+	for _, a := range archetypes {
+		report.Archetypes = append(report.Archetypes, a.entry(matches, kills))
+	}
+	report.Weapons = mostUsed(report.Weapons, top)
+	report.Exotics = mostUsed(report.Exotics, top)
+	report.Archetypes = mostUsed(report.Archetypes, len(report.Archetypes))
+	return report
+}
+
+func newUsage(hash *int64, name string) *usage {
+	return &usage{hash: hash, name: name, players: make(map[string]bool)}
+}
+
+func (u *usage) count(characterID string, kills int, won bool) {
+	u.players[characterID] = true
+	u.matches++
+	u.kills += kills
+	if won {
+		u.wins++
+	}
+}
+
+func (u *usage) entry(matches, kills int) api.MetaEntry {
+	return api.MetaEntry{
+		Hash:       u.hash,
+		Name:       u.name,
+		Icon:       u.icon,
+		Players:    len(u.players),
+		Matches:    u.matches,
+		UsageShare: destiny.Ratio(u.matches, matches),
+		Kills:      u.kills,
+		KillShare:  destiny.Ratio(u.kills, kills),
+		Wins:       u.wins,
+		WinRate:    destiny.Ratio(u.wins, u.matches),
+	}
+}
+
+// mostUsed sorts the entries by matches, then kills, and keeps the first top.
+func mostUsed(entries []api.MetaEntry, top int) []api.MetaEntry {
+	slices.SortFunc(entries, func(a, b api.MetaEntry) int {
+		if c := cmp.Compare(b.Matches, a.Matches); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Kills, a.Kills); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	if len(entries) > top {
+		entries = entries[:top]
+	}
+	return entries
+}
+
+// weaponName prefers the name recorded with the match, falling back to the manifest.
+func weaponName(weapon api.WeaponInstanceMetrics, item destiny.ItemDefinition) string {
+	if weapon.Display != nil && weapon.Display.Name != "" {
+		return weapon.Display.Name
+	}
+	return item.DisplayProperties.Name
+}
+
+func isExotic(item destiny.ItemDefinition) bool {
+	return item.Inventory.TierType == exoticTierType || item.Inventory.TierTypeName == exoticTierName
+}
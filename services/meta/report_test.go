@@ -0,0 +1,80 @@
+package meta
+
+import (
+	"oneTrick/api"
+	"oneTrick/internal/fixtures"
+	"oneTrick/services/destiny"
+	"testing"
+	"time"
+)
+
+// Director activity hashes of the playlists the fixtures are played in
+const (
+	trialsHash  = int64(1)
+	controlHash = int64(2)
+)
+
+func metaMatch(period time.Time, activityHash int64, won bool, weapons map[string][]int64) api.Aggregate {
+	performance := make(map[string]api.InstancePerformance)
+	for characterID, hashes := range weapons {
+		performance[characterID] = api.InstancePerformance{
+			PlayerStats: fixtures.PlayerStats(0, 0, won),
+			Weapons:     fixtures.Weapons(2, hashes...),
+		}
+	}
+	return api.Aggregate{
+		ActivityDetails: api.ActivityHistory{Period: period, ActivityHash: activityHash},
+		Performance:     performance,
+	}
+}
+
+func TestBuild(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	items := map[int64]destiny.ItemDefinition{
+		1: {ItemTypeDisplayName: "Hand Cannon"},
+		2: {ItemTypeDisplayName: "Hand Cannon", Inventory: destiny.Inventory{TierType: exoticTierType}},
+		3: {ItemTypeDisplayName: "Sniper Rifle"},
+	}
+	aggs := []api.Aggregate{
+		metaMatch(now.Add(-time.Hour), trialsHash, true, map[string][]int64{"a": {1, 3}, "b": {1}}),
+		metaMatch(now.Add(-2*time.Hour), trialsHash, false, map[string][]int64{"a": {2}}),
+		metaMatch(now.Add(-3*time.Hour), controlHash, true, map[string][]int64{"c": {3}}),
+		metaMatch(now.Add(-48*time.Hour), trialsHash, true, map[string][]int64{"d": {3}}),
+	}
+
+	got := build(aggs, items, []int64{trialsHash}, now.Add(-24*time.Hour), now, 2)
+	if got.Players != 2 || got.Matches != 3 {
+		t.Fatalf("build() players, matches = %d, %d, want 2, 3", got.Players, got.Matches)
+	}
+	if len(got.Weapons) != 2 || *got.Weapons[0].Hash != 1 || got.Weapons[0].Matches != 2 || got.Weapons[0].Players != 2 {
+		t.Errorf("build() weapons = %+v, want hash 1 first with 2 matches by 2 players", got.Weapons)
+	}
+	if got.Weapons[0].WinRate != 1 || got.Weapons[0].KillShare != 0.5 {
+		t.Errorf("build() weapon 1 win rate, kill share = %v, %v, want 1, 0.5", got.Weapons[0].WinRate, got.Weapons[0].KillShare)
+	}
+	if len(got.Exotics) != 1 || *got.Exotics[0].Hash != 2 || got.Exotics[0].WinRate != 0 {
+		t.Errorf("build() exotics = %+v, want only hash 2", got.Exotics)
+	}
+	if len(got.Archetypes) != 2 || got.Archetypes[0].Name != "Hand Cannon" || got.Archetypes[0].Matches != 3 {
+		t.Errorf("build() archetypes = %+v, want hand cannons first with 3 matches", got.Archetypes)
+	}
+
+	got = build(aggs, items, nil, now.Add(-24*time.Hour), now, 25)
+	if got.Matches != 4 || len(got.Weapons) != 3 {
+		t.Errorf("build() across every mode = %d matches, %d weapons, want 4, 3", got.Matches, len(got.Weapons))
+	}
+}
+
+func TestBuildCountsArchetypeOncePerMatch(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	items := map[int64]destiny.ItemDefinition{
+		1: {ItemTypeDisplayName: "Hand Cannon"},
+		2: {ItemTypeDisplayName: "Hand Cannon"},
+	}
+	aggs := []api.Aggregate{metaMatch(now.Add(-time.Hour), trialsHash, true, map[string][]int64{"a": {1, 2}})}
+
+	got := build(aggs, items, nil, now.Add(-24*time.Hour), now, 25)
+	if len(got.Archetypes) != 1 || got.Archetypes[0].Matches != 1 || got.Archetypes[0].UsageShare != 1 || got.Archetypes[0].Kills != 4 {
+		t.Errorf("build() archetypes = %+v, want hand cannons in 1 match with every kill", got.Archetypes)
+	}
+}
@@ -0,0 +1,5 @@
+package meta
+
+import "errors"
+
+var NotFound = errors.New("meta report not found")
@@ -0,0 +1,76 @@
+package rollup
+
+import (
+	"math"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+)
+
+const (
+	// initialRating and initialDeviation are the Glicko defaults for a loadout without games.
+	initialRating    = 1500.0
+	initialDeviation = 350.0
+	// minimumDeviation keeps ratings moving after many games.
+	minimumDeviation = 30.0
+	// opponentDeviation is the uncertainty of the opponents' rating estimated from their efficiency.
+	opponentDeviation = 50.0
+	// populationEfficiency is the (kills + assists) / deaths of an average lobby, where kills even out with deaths
+	// and assists add about 40% on top. Opponents this efficient are rated initialRating.
+	populationEfficiency = 1.4
+	// efficiencyScale turns the opponents' efficiency relative to the population into rating points, opponents
+	// twice as efficient are rated about 120 points higher.
+	efficiencyScale = 400.0
+)
+
+// glickoQ is ln(10) / 400, the scale of Glicko ratings.
+var glickoQ = math.Ln10 / 400
+
+// rate updates the rollup's rating with the character's result in the aggregate, against the opponents' rating
+// estimated from the lobby. Games without a lobby estimate are rated against opponents of the loadout's own rating.
+func rate(r *api.StatsRollup, agg api.Aggregate, characterID string) {
+	performance, ok := agg.Performance[characterID]
+	if !ok {
+		return
+	}
+	rating := r.Rating
+	if rating == nil {
+		rating = &api.LoadoutRating{Rating: initialRating, Deviation: initialDeviation}
+	}
+	score := 0.0
+	stats := performance.PlayerStats
+	if destiny.IsWin(stats) {
+		score = 1
+	}
+	opponent, ok := opponentRating(agg, characterID)
+	if !ok {
+		opponent = rating.Rating
+	}
+	updated := glicko(*rating, opponent, score)
+	updated.Matches = rating.Matches + 1
+	r.Rating = &updated
+}
+
+// opponentRating maps the efficiency of the character's opponents to a rating, relative to the population's
+// efficiency. Returns false when the aggregate has no lobby estimate.
+func opponentRating(agg api.Aggregate, characterID string) (float64, bool) {
+	strength, ok := destiny.OpponentStrength(agg, characterID)
+	if !ok || strength <= 0 {
+		return 0, false
+	}
+	return initialRating + efficiencyScale*math.Log10(strength/populationEfficiency), true
+}
+
+// glicko applies a single Glicko-1 rating period with one game against an opponent of the given rating.
+func glicko(current api.LoadoutRating, opponent, score float64) api.LoadoutRating {
+	g := 1 / math.Sqrt(1+3*glickoQ*glickoQ*opponentDeviation*opponentDeviation/(math.Pi*math.Pi))
+	expected := 1 / (1 + math.Pow(10, -g*(current.Rating-opponent)/400))
+	dSquared := 1 / (glickoQ * glickoQ * g * g * expected * (1 - expected))
+	precision := 1/(current.Deviation*current.Deviation) + 1/dSquared
+
+	result := api.LoadoutRating{
+		Rating:    current.Rating + glickoQ/precision*g*(score-expected),
+		Deviation: math.Max(math.Sqrt(1/precision), minimumDeviation),
+	}
+	result.Conservative = result.Rating - 2*result.Deviation
+	return result
+}
@@ -0,0 +1,450 @@
+package rollup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"oneTrick/api"
+	"oneTrick/utils"
+	"slices"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Service maintains stats rollups, running totals of a character's games per mode and per weapon, so stats
+// don't have to re-read every aggregate. Aggregates are counted at most once per character. The snapshot
+// they were counted under is kept on the aggregate, so re-linking moves the games between snapshot rollups.
+type Service interface {
+	// Sync counts every aggregate of the character created since the last sync and returns the character
+	// rollup. Returns NotFound when the character doesn't have one yet and Stale when it's waiting for Rebuild.
+	Sync(ctx context.Context, characterID string) (*api.StatsRollup, error)
+
+	// Apply counts the aggregate in the character's rollups. Returns NotFound when the character doesn't have one
+	// yet. When the game was counted under another snapshot, or was played before the latest game of its snapshot,
+	// the rollups are marked stale and Stale is returned, so ratings are only replayed in the order the games were
+	// played by Rebuild. Calling it again without changes is a no-op.
+	Apply(ctx context.Context, aggregateID, characterID string) error
+
+	// GetCharacter returns the character rollup as stored, without syncing it, or NotFound when it hasn't been built.
+	GetCharacter(ctx context.Context, characterID string) (*api.StatsRollup, error)
+
+	// GetSnapshots returns the rollups of every snapshot the character has games with.
+	GetSnapshots(ctx context.Context, characterID string) ([]api.StatsRollup, error)
+
+	// GetSnapshot returns the rollup of a single snapshot, or NotFound when it has no games.
+	GetSnapshot(ctx context.Context, snapshotID string) (*api.StatsRollup, error)
+
+	// Rebuild regenerates every rollup of the character from its aggregates, replaying snapshot ratings
+	// in the order the games were played. It reads every aggregate of the character, so it runs from the
+	// admin job rather than on requests.
+	Rebuild(ctx context.Context, characterID string) error
+}
+
+const (
+	collection           = "rollups"
+	aggregatesCollection = "aggregates"
+)
+
+type service struct {
+	db *firestore.Client
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(db *firestore.Client) Service {
+	return &service{
+		db: db,
+	}
+}
+
+func characterRollupID(characterID string) string {
+	return "character_" + characterID
+}
+
+func snapshotRollupID(snapshotID string) string {
+	return "snapshot_" + snapshotID
+}
+
+func (s *service) Sync(ctx context.Context, characterID string) (*api.StatsRollup, error) {
+	if characterID == "" {
+		return nil, fmt.Errorf("characterID is required")
+	}
+	result, err := s.get(ctx, characterRollupID(characterID))
+	if err != nil {
+		return nil, err
+	}
+	if isStale(result) {
+		return nil, Stale
+	}
+
+	q := s.db.Collection(aggregatesCollection).Where("characterIds", "array-contains", characterID)
+	if result.LastAggregateCreatedAt != nil {
+		// Aggregates sharing the newest timestamp are fetched again, Apply skips the ones already counted
+		q = q.Where("createdAt", ">=", *result.LastAggregateCreatedAt)
+	}
+	docs, err := q.OrderBy("createdAt", firestore.Asc).Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	aggs, err := utils.GetAllToStructs[api.Aggregate](docs)
+	if err != nil {
+		return nil, err
+	}
+	// Ratings are replayed in the order the games were played, which can differ from the order they were stored in
+	slices.SortFunc(aggs, func(a, b api.Aggregate) int {
+		return a.ActivityDetails.Period.Compare(b.ActivityDetails.Period)
+	})
+	applied := 0
+	for _, agg := range aggs {
+		if previous, counted := countedUnder(agg, characterID); counted && previous == linkedSnapshotID(agg, characterID) {
+			continue
+		}
+		if err := s.Apply(ctx, agg.ID, characterID); errors.Is(err, Stale) {
+			return nil, Stale
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to apply aggregate %s: %w", agg.ID, err)
+		}
+		applied++
+	}
+	if applied == 0 {
+		return result, nil
+	}
+	log.Debug().Str("characterID", characterID).Int("applied", applied).Msg("synced rollups")
+	return s.get(ctx, characterRollupID(characterID))
+}
+
+func (s *service) Apply(ctx context.Context, aggregateID, characterID string) error {
+	stale := false
+	err := s.db.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		stale = false
+		aggRef := s.db.Collection(aggregatesCollection).Doc(aggregateID)
+		doc, err := tx.Get(aggRef)
+		if err != nil {
+			return err
+		}
+		var agg api.Aggregate
+		if err := doc.DataTo(&agg); err != nil {
+			return err
+		}
+		character, built, err := s.getForUpdate(tx, newCharacterRollup(characterID))
+		if err != nil {
+			return err
+		}
+		if isStale(character) {
+			stale = true
+			return nil
+		}
+		m, ok := plan(agg, characterID, built)
+		if !ok {
+			return nil
+		}
+		if m.rebuild && !built {
+			return NotFound
+		}
+		if m.rebuild {
+			stale = true
+			return s.markStale(tx, character)
+		}
+
+		var snapshot *api.StatsRollup
+		if m.to != "" {
+			snapshot, _, err = s.getForUpdate(tx, newSnapshotRollup(characterID, m.to))
+			if err != nil {
+				return err
+			}
+			// Ratings can't be replayed from the middle, a game played before the snapshot's latest waits for Rebuild
+			if snapshot.LastPlayedAt != nil && agg.ActivityDetails.Period.Before(*snapshot.LastPlayedAt) {
+				stale = true
+				return s.markStale(tx, character)
+			}
+		}
+
+		now := time.Now()
+		if character.LastAggregateCreatedAt == nil || agg.CreatedAt.After(*character.LastAggregateCreatedAt) {
+			character.LastAggregateCreatedAt = &agg.CreatedAt
+		}
+		rollups := []*api.StatsRollup{character}
+		if snapshot != nil {
+			rollups = append(rollups, snapshot)
+		}
+		for _, r := range rollups {
+			add(r, agg, characterID)
+			if r.Type == api.RollupTypeSnapshot {
+				rate(r, agg, characterID)
+			}
+			r.UpdatedAt = now
+			if err := tx.Set(s.db.Collection(collection).Doc(r.ID), r); err != nil {
+				return err
+			}
+		}
+		return tx.Update(aggRef, []firestore.Update{
+			{
+				FieldPath: firestore.FieldPath{"rollupLinks", characterID},
+				Value:     m.to,
+			},
+		})
+	})
+	if err == nil && stale {
+		return Stale
+	}
+	return err
+}
+
+// markStale flags the character rollup for the admin rebuild within the transaction.
+func (s *service) markStale(tx *firestore.Transaction, character *api.StatsRollup) error {
+	stale := true
+	character.Stale = &stale
+	character.UpdatedAt = time.Now()
+	return tx.Set(s.db.Collection(collection).Doc(character.ID), character)
+}
+
+// isStale reports whether the rollup waits for Rebuild, which includes the rollups from before the mode buckets were
+// keyed by activity hash.
+func isStale(r *api.StatsRollup) bool {
+	if r.Stale != nil && *r.Stale {
+		return true
+	}
+	for key := range r.Modes {
+		if _, err := strconv.ParseInt(key, 10, 64); err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// move is how counting an aggregate changes the character's rollups.
+type move struct {
+	// rebuild is set when the game can't be counted on its own and waits for Rebuild. Without a character rollup
+	// every older game would be missing, which Sync would then never pick up. A game moving to another snapshot
+	// can't be taken out of the rating it was counted in.
+	rebuild bool
+	// to is the snapshot the game joins, empty for none.
+	to string
+}
+
+// plan works out how Apply counts the aggregate, given whether the character rollup exists. Returns false when
+// there's nothing to do.
+func plan(agg api.Aggregate, characterID string, built bool) (move, bool) {
+	if _, ok := agg.Performance[characterID]; !ok {
+		return move{}, false
+	}
+	current := linkedSnapshotID(agg, characterID)
+	previous, counted := countedUnder(agg, characterID)
+	if counted && previous == current {
+		return move{}, false
+	}
+	if !built || counted {
+		return move{rebuild: true}, true
+	}
+	return move{to: current}, true
+}
+
+func (s *service) GetCharacter(ctx context.Context, characterID string) (*api.StatsRollup, error) {
+	return s.get(ctx, characterRollupID(characterID))
+}
+
+func (s *service) GetSnapshots(ctx context.Context, characterID string) ([]api.StatsRollup, error) {
+	docs, err := s.db.Collection(collection).
+		Where("characterId", "==", characterID).
+		Where("type", "==", api.RollupTypeSnapshot).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return utils.GetAllToStructs[api.StatsRollup](docs)
+}
+
+func (s *service) GetSnapshot(ctx context.Context, snapshotID string) (*api.StatsRollup, error) {
+	return s.get(ctx, snapshotRollupID(snapshotID))
+}
+
+func (s *service) Rebuild(ctx context.Context, characterID string) error {
+	if characterID == "" {
+		return fmt.Errorf("characterID is required")
+	}
+	docs, err := s.db.Collection(aggregatesCollection).
+		Where("characterIds", "array-contains", characterID).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return err
+	}
+	aggs, err := utils.GetAllToStructs[api.Aggregate](docs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	_, rollups, links := build(aggs, characterID)
+
+	existing, err := s.db.Collection(collection).Where("characterId", "==", characterID).Documents(ctx).GetAll()
+	if err != nil {
+		return err
+	}
+
+	bw := s.db.BulkWriter(ctx)
+	jobs := make([]*firestore.BulkWriterJob, 0, len(existing)+len(rollups)+len(links))
+	for _, doc := range existing {
+		// Snapshots that no longer have games, e.g. merged away, are removed
+		if _, ok := rollups[doc.Ref.ID]; !ok {
+			job, err := bw.Delete(doc.Ref)
+			if err != nil {
+				return err
+			}
+			jobs = append(jobs, job)
+		}
+	}
+	for id, r := range rollups {
+		r.UpdatedAt = now
+		job, err := bw.Set(s.db.Collection(collection).Doc(id), r)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+	}
+	for id, snapshotID := range links {
+		job, err := bw.Update(s.db.Collection(aggregatesCollection).Doc(id), []firestore.Update{
+			{
+				FieldPath: firestore.FieldPath{"rollupLinks", characterID},
+				Value:     snapshotID,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+	}
+	if err := utils.EndBulkWriter(bw, jobs); err != nil {
+		return fmt.Errorf("failed to write rollups: %w", err)
+	}
+	log.Info().Str("characterID", characterID).Int("aggregates", len(links)).Int("rollups", len(rollups)).Msg("rebuilt rollups")
+	return nil
+}
+
+// Build computes the character and snapshot rollups of the aggregates in memory, for the stats that need a subset
+// of the games the stored rollups can't tell apart.
+func Build(aggs []api.Aggregate, characterID string) (*api.StatsRollup, []api.StatsRollup) {
+	character, rollups, _ := build(aggs, characterID)
+	snapshots := make([]api.StatsRollup, 0, len(rollups))
+	for _, r := range rollups {
+		if r.Type == api.RollupTypeSnapshot {
+			snapshots = append(snapshots, *r)
+		}
+	}
+	return character, snapshots
+}
+
+// build counts every aggregate of the character, replaying snapshot ratings in the order the games were played.
+// Returns the rollups keyed by ID, including the character rollup, and the snapshot each aggregate was counted
+// under.
+func build(aggs []api.Aggregate, characterID string) (*api.StatsRollup, map[string]*api.StatsRollup, map[string]string) {
+	aggs = slices.Clone(aggs)
+	slices.SortFunc(aggs, func(a, b api.Aggregate) int {
+		return a.ActivityDetails.Period.Compare(b.ActivityDetails.Period)
+	})
+
+	character := newCharacterRollup(characterID)
+	rollups := map[string]*api.StatsRollup{character.ID: character}
+	links := make(map[string]string, len(aggs))
+	for _, agg := range aggs {
+		if _, ok := agg.Performance[characterID]; !ok {
+			continue
+		}
+		add(character, agg, characterID)
+		if character.LastAggregateCreatedAt == nil || agg.CreatedAt.After(*character.LastAggregateCreatedAt) {
+			character.LastAggregateCreatedAt = &agg.CreatedAt
+		}
+		snapshotID := linkedSnapshotID(agg, characterID)
+		links[agg.ID] = snapshotID
+		if snapshotID == "" {
+			continue
+		}
+		r, ok := rollups[snapshotRollupID(snapshotID)]
+		if !ok {
+			r = newSnapshotRollup(characterID, snapshotID)
+			rollups[r.ID] = r
+		}
+		add(r, agg, characterID)
+		rate(r, agg, characterID)
+	}
+	return character, rollups, links
+}
+
+func (s *service) get(ctx context.Context, id string) (*api.StatsRollup, error) {
+	doc, err := s.db.Collection(collection).Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, NotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := &api.StatsRollup{}
+	if err := doc.DataTo(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getForUpdate reads the rollup within the transaction, returning fallback when it doesn't exist yet along with
+// whether it exists.
+func (s *service) getForUpdate(tx *firestore.Transaction, fallback *api.StatsRollup) (*api.StatsRollup, bool, error) {
+	doc, err := tx.Get(s.db.Collection(collection).Doc(fallback.ID))
+	if status.Code(err) == codes.NotFound {
+		return fallback, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	result := &api.StatsRollup{}
+	if err := doc.DataTo(result); err != nil {
+		return nil, false, err
+	}
+	return result, true, nil
+}
+
+func newCharacterRollup(characterID string) *api.StatsRollup {
+	return &api.StatsRollup{
+		ID:          characterRollupID(characterID),
+		Type:        api.RollupTypeCharacter,
+		CharacterID: characterID,
+		Overall:     newBucket(),
+		Modes:       make(map[string]api.RollupBucket),
+	}
+}
+
+func newSnapshotRollup(characterID, snapshotID string) *api.StatsRollup {
+	return &api.StatsRollup{
+		ID:          snapshotRollupID(snapshotID),
+		Type:        api.RollupTypeSnapshot,
+		CharacterID: characterID,
+		SnapshotID:  &snapshotID,
+		Overall:     newBucket(),
+		Modes:       make(map[string]api.RollupBucket),
+	}
+}
+
+func newBucket() api.RollupBucket {
+	return api.RollupBucket{Weapons: make(map[string]api.RollupWeapon)}
+}
+
+// linkedSnapshotID returns the snapshot the character is linked to in the aggregate, or an empty string.
+func linkedSnapshotID(agg api.Aggregate, characterID string) string {
+	link, ok := agg.SnapshotLinks[characterID]
+	if !ok || link.SnapshotID == nil {
+		return ""
+	}
+	return *link.SnapshotID
+}
+
+// countedUnder returns the snapshot the aggregate was counted under for the character, and whether it was counted at all.
+func countedUnder(agg api.Aggregate, characterID string) (string, bool) {
+	if agg.RollupLinks == nil {
+		return "", false
+	}
+	snapshotID, ok := (*agg.RollupLinks)[characterID]
+	return snapshotID, ok
+}
@@ -0,0 +1,140 @@
+package rollup
+
+import (
+	"oneTrick/api"
+	"testing"
+	"time"
+)
+
+func rollupMatch(id string, period time.Time, snapshotID string, counted *string) api.Aggregate {
+	kills, deaths, standing := 10.0, 5.0, 0.0
+	agg := api.Aggregate{
+		ID:              id,
+		CreatedAt:       period,
+		ActivityDetails: api.ActivityHistory{Period: period, Activity: "Control"},
+		Performance: map[string]api.InstancePerformance{
+			"c": {PlayerStats: api.PlayerStats{
+				Kills:    &api.StatsValuePair{Value: &kills},
+				Deaths:   &api.StatsValuePair{Value: &deaths},
+				Standing: &api.StatsValuePair{Value: &standing},
+			}},
+		},
+		SnapshotLinks: map[string]api.SnapshotLink{"c": {SnapshotID: &snapshotID}},
+	}
+	if counted != nil {
+		agg.RollupLinks = &map[string]string{"c": *counted}
+	}
+	return agg
+}
+
+func TestPlan(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	source := "source"
+	tests := []struct {
+		name   string
+		agg    api.Aggregate
+		built  bool
+		want   move
+		wantOK bool
+	}{
+		{
+			name:   "merge before first sync",
+			agg:    rollupMatch("a", now, "target", nil),
+			built:  false,
+			want:   move{rebuild: true},
+			wantOK: true,
+		},
+		{
+			name:   "new game",
+			agg:    rollupMatch("a", now, "target", nil),
+			built:  true,
+			want:   move{to: "target"},
+			wantOK: true,
+		},
+		{
+			name:   "merged game",
+			agg:    rollupMatch("a", now, "target", &source),
+			built:  true,
+			want:   move{rebuild: true},
+			wantOK: true,
+		},
+		{
+			name:  "already counted",
+			agg:   rollupMatch("a", now, "source", &source),
+			built: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := plan(tt.agg, "c", tt.built)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("plan() = %+v, %v, want %+v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestBuildAfterMergeBeforeFirstSync(t *testing.T) {
+	// Older games were never counted, the newest was merged into the target before the first sync
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	aggs := []api.Aggregate{
+		rollupMatch("a", now, "source", nil),
+		rollupMatch("b", now.Add(time.Hour), "other", nil),
+		rollupMatch("c", now.Add(2*time.Hour), "target", nil),
+	}
+
+	character, rollups, links := build(aggs, "c")
+	if character.Overall.Totals.Matches != 3 || !character.LastAggregateCreatedAt.Equal(now.Add(2*time.Hour)) {
+		t.Errorf("build() character = %+v, want every game counted", character.Overall.Totals)
+	}
+	if len(rollups) != 4 || rollups[snapshotRollupID("target")].Overall.Totals.Matches != 1 {
+		t.Errorf("build() rollups = %d, want the character and three snapshots", len(rollups))
+	}
+	if links["c"] != "target" || links["a"] != "source" {
+		t.Errorf("build() links = %v, want each game under its linked snapshot", links)
+	}
+}
+
+func TestBuildRatesInPlayedOrder(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	aggs := make([]api.Aggregate, 0, 4)
+	for i, won := range []bool{true, false, false, true} {
+		agg := rollupMatch(string(rune('a'+i)), now.Add(time.Duration(i)*time.Hour), "target", nil)
+		if !won {
+			lost := 1.0
+			agg.Performance["c"].PlayerStats.Standing.Value = &lost
+		}
+		// Stored newest first, the way a late ingest would
+		agg.CreatedAt = now.Add(-time.Duration(i) * time.Hour)
+		aggs = append(aggs, agg)
+	}
+	reversed := []api.Aggregate{aggs[3], aggs[2], aggs[1], aggs[0]}
+
+	_, inOrder, _ := build(aggs, "c")
+	_, outOfOrder, _ := build(reversed, "c")
+	want, got := inOrder[snapshotRollupID("target")], outOfOrder[snapshotRollupID("target")]
+	if *got.Rating != *want.Rating {
+		t.Errorf("build() rating = %+v, want %+v regardless of storage order", *got.Rating, *want.Rating)
+	}
+	if got.LastPlayedAt == nil || !got.LastPlayedAt.Equal(now.Add(3*time.Hour)) {
+		t.Errorf("build() lastPlayedAt = %v, want the latest game's period", got.LastPlayedAt)
+	}
+}
+
+func TestRateAgainstLobby(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rated := func(efficiency float64) api.LoadoutRating {
+		agg := rollupMatch("a", now, "target", nil)
+		agg.LobbyStrength = &api.LobbyStrength{Players: 6, Efficiency: efficiency}
+		r := newSnapshotRollup("c", "target")
+		rate(r, agg, "c")
+		return *r.Rating
+	}
+	average, strong := rated(populationEfficiency), rated(2*populationEfficiency)
+	if average.Rating <= initialRating {
+		t.Errorf("rate() against an average lobby = %v, want above %v after a win", average.Rating, initialRating)
+	}
+	if strong.Rating <= average.Rating {
+		t.Errorf("rate() against a strong lobby = %v, want above %v", strong.Rating, average.Rating)
+	}
+}
@@ -0,0 +1,83 @@
+package rollup
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"strconv"
+)
+
+// ModeKey returns the key of the activity's bucket in a rollup's modes.
+func ModeKey(activityHash int64) string {
+	return strconv.FormatInt(activityHash, 10)
+}
+
+// add counts the character's game in the aggregate into the rollup's overall and mode totals.
+func add(r *api.StatsRollup, agg api.Aggregate, characterID string) {
+	performance, ok := agg.Performance[characterID]
+	if !ok {
+		return
+	}
+	addToBucket(&r.Overall, performance)
+	if period := agg.ActivityDetails.Period; r.LastPlayedAt == nil || period.After(*r.LastPlayedAt) {
+		r.LastPlayedAt = &period
+	}
+
+	if r.Modes == nil {
+		r.Modes = make(map[string]api.RollupBucket)
+	}
+	mode := ModeKey(agg.ActivityDetails.ActivityHash)
+	bucket, ok := r.Modes[mode]
+	if !ok {
+		bucket = newBucket()
+	}
+	if name := agg.ActivityDetails.Activity; name != "" {
+		bucket.Activity = &name
+	}
+	addToBucket(&bucket, performance)
+	r.Modes[mode] = bucket
+}
+
+func addToBucket(b *api.RollupBucket, performance api.InstancePerformance) {
+	stats := destiny.WithAbilityKills(performance)
+	kills := destiny.PairValue(stats.Kills)
+	deaths := destiny.PairValue(stats.Deaths)
+	won := destiny.IsWin(stats)
+
+	t := &b.Totals
+	t.Matches++
+	if won {
+		t.Wins++
+	}
+	t.Kills += kills
+	t.Deaths += deaths
+	t.Assists += destiny.PairValue(stats.Assists)
+	t.SecondsPlayed += destiny.PairValue(stats.TimePlayed)
+	t.KillsSquared += kills * kills
+	t.DeathsSquared += deaths * deaths
+	t.KillsDeaths += kills * deaths
+	t.GrenadeKills += destiny.PairValue(stats.GrenadeKills)
+	t.MeleeKills += destiny.PairValue(stats.MeleeKills)
+	t.SuperKills += destiny.PairValue(stats.SuperKills)
+	t.AbilityKills += destiny.PairValue(stats.AbilityKills)
+
+	if b.Weapons == nil {
+		b.Weapons = make(map[string]api.RollupWeapon)
+	}
+	for key, metric := range performance.Weapons {
+		if metric.ReferenceID == nil {
+			continue
+		}
+		w := b.Weapons[key]
+		w.ReferenceID = *metric.ReferenceID
+		if w.Display == nil {
+			w.Display = metric.Display
+		}
+		w.Matches++
+		if won {
+			w.Wins++
+		}
+		w.Kills += destiny.StatValue(metric.Stats, destiny.WeaponKillsStat)
+		w.PrecisionKills += destiny.StatValue(metric.Stats, destiny.WeaponPrecisionKillsStat)
+		b.Weapons[key] = w
+	}
+}
@@ -0,0 +1,10 @@
+package rollup
+
+import "errors"
+
+var (
+	NotFound = errors.New("rollup not found")
+
+	// Stale is returned when the character's rollups have a game they can't count on their own and wait for Rebuild.
+	Stale = errors.New("rollup is stale")
+)
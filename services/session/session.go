@@ -21,6 +21,8 @@ type Service interface {
 	Get(ctx context.Context, ID string) (*api.Session, error)
 	GetActive(ctx context.Context, userID string, characterID string) (*api.Session, error)
 	GetAll(ctx context.Context, userID *string, characterID *string, status *api.SessionStatus, count int, offset int) ([]api.Session, error)
+	// GetStartedBetween returns every session of the user started within from and to, newest first.
+	GetStartedBetween(ctx context.Context, userID string, from, to time.Time) ([]api.Session, error)
 	Complete(ctx context.Context, ID string) error
 	SetLastActivity(ctx context.Context, ID, activityID string) error
 }
@@ -166,6 +168,19 @@ func (s service) GetAll(ctx context.Context, userID *string, characterID *string
 	return result, nil
 }
 
+func (s service) GetStartedBetween(ctx context.Context, userID string, from, to time.Time) ([]api.Session, error) {
+	docs, err := s.db.Collection(collection).
+		Where("userId", "==", userID).
+		Where("startedAt", ">=", from).
+		Where("startedAt", "<", to).
+		OrderBy("startedAt", firestore.Desc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return utils.GetAllToStructs[api.Session](docs)
+}
+
 func (s service) Update(ctx context.Context, sessionID string, name, description string) error {
 	ref := s.db.Collection(collection).Doc(sessionID)
 
@@ -0,0 +1,158 @@
+package share
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"oneTrick/api"
+	"oneTrick/utils"
+	"slices"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/firestore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Service manages public, read-only share links for snapshots and sessions.
+type Service interface {
+	// Create generates a new share link with an unguessable token for the given resource.
+	// The caller is responsible for checking the user owns the resource.
+	Create(ctx context.Context, userID string, shareType api.ShareType, resourceID string, expiresAt *time.Time) (*api.ShareLink, error)
+
+	// Get returns the share link for a token, whether it is still active or not.
+	// Returns NotFound if no link exists for the token.
+	Get(ctx context.Context, token string) (*api.ShareLink, error)
+
+	// Resolve returns the share link for a token only when it can still be used.
+	// Returns NotFound, Revoked or Expired otherwise.
+	Resolve(ctx context.Context, token string) (*api.ShareLink, error)
+
+	// GetAllByUser returns the links created by a user, newest first. Optionally limited to a single resource.
+	GetAllByUser(ctx context.Context, userID string, resourceID *string) ([]api.ShareLink, error)
+
+	// Revoke marks the link as revoked so Resolve no longer returns it.
+	Revoke(ctx context.Context, token string) (*api.ShareLink, error)
+}
+
+const (
+	collection = "shareLinks"
+	tokenBytes = 32
+)
+
+type service struct {
+	db *firestore.Client
+}
+
+var _ Service = (*service)(nil)
+
+func NewService(db *firestore.Client) Service {
+	return &service{
+		db: db,
+	}
+}
+
+func (s *service) Create(ctx context.Context, userID string, shareType api.ShareType, resourceID string, expiresAt *time.Time) (*api.ShareLink, error) {
+	token, err := newToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate share token: %w", err)
+	}
+	result := &api.ShareLink{
+		ID:         token,
+		Type:       shareType,
+		ResourceID: resourceID,
+		UserID:     userID,
+		CreatedAt:  time.Now(),
+		ExpiresAt:  expiresAt,
+	}
+	_, err = s.db.Collection(collection).Doc(token).Set(ctx, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *service) Get(ctx context.Context, token string) (*api.ShareLink, error) {
+	// Tokens are URL safe, anything else can't be a document id
+	if token == "" || strings.Contains(token, "/") {
+		return nil, NotFound
+	}
+	doc, err := s.db.Collection(collection).Doc(token).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, NotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	result := &api.ShareLink{}
+	if err := doc.DataTo(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *service) Resolve(ctx context.Context, token string) (*api.ShareLink, error) {
+	link, err := s.Get(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if link.RevokedAt != nil {
+		return nil, Revoked
+	}
+	if link.ExpiresAt != nil && link.ExpiresAt.Before(time.Now()) {
+		return nil, Expired
+	}
+	return link, nil
+}
+
+func (s *service) GetAllByUser(ctx context.Context, userID string, resourceID *string) ([]api.ShareLink, error) {
+	q := s.db.Collection(collection).Where("userId", "==", userID)
+	if resourceID != nil {
+		q = q.Where("resourceId", "==", *resourceID)
+	}
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		return nil, err
+	}
+	results, err := utils.GetAllToStructs[api.ShareLink](docs)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(results, func(a, b api.ShareLink) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+	return results, nil
+}
+
+func (s *service) Revoke(ctx context.Context, token string) (*api.ShareLink, error) {
+	link, err := s.Get(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if link.RevokedAt != nil {
+		return link, nil
+	}
+	now := time.Now()
+	_, err = s.db.Collection(collection).Doc(link.ID).Update(ctx, []firestore.Update{
+		{
+			Path:  "revokedAt",
+			Value: now,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke share link: %w", err)
+	}
+	link.RevokedAt = &now
+	return link, nil
+}
+
+// newToken returns a random, URL safe token.
+func newToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
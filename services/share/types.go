@@ -0,0 +1,9 @@
+package share
+
+import "errors"
+
+var (
+	NotFound = errors.New("share link not found")
+	Revoked  = errors.New("share link revoked")
+	Expired  = errors.New("share link expired")
+)
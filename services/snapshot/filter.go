@@ -0,0 +1,115 @@
+package snapshot
+
+import (
+	"oneTrick/api"
+	"strings"
+)
+
+// Filter narrows down the snapshots returned for a character. Empty or nil fields are ignored,
+// except for Archived where nil means archived snapshots are hidden.
+type Filter struct {
+	Search     string
+	Tag        string
+	Favorite   *bool
+	Archived   *bool
+	WeaponHash *int64
+	DamageType string
+}
+
+// Matches reports whether the snapshot satisfies every condition of the filter.
+func (f Filter) Matches(snapshot api.CharacterSnapshot) bool {
+	archived := snapshot.Archived != nil && *snapshot.Archived
+	if f.Archived == nil && archived {
+		return false
+	}
+	if f.Archived != nil && *f.Archived != archived {
+		return false
+	}
+	if f.Favorite != nil {
+		favorite := snapshot.Favorite != nil && *snapshot.Favorite
+		if *f.Favorite != favorite {
+			return false
+		}
+	}
+	if f.Tag != "" && !HasTag(snapshot, f.Tag) {
+		return false
+	}
+	if f.WeaponHash != nil && !hasItem(snapshot, *f.WeaponHash) {
+		return false
+	}
+	if f.DamageType != "" && !hasDamageType(snapshot, f.DamageType) {
+		return false
+	}
+	if f.Search != "" && !matchesSearch(snapshot, f.Search) {
+		return false
+	}
+	return true
+}
+
+// NormalizeTags trims whitespace, drops empty tags and removes case-insensitive duplicates,
+// keeping the first spelling provided.
+func NormalizeTags(tags []string) []string {
+	results := make([]string, 0, len(tags))
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		key := strings.ToLower(tag)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		results = append(results, tag)
+	}
+	return results
+}
+
+// HasTag reports whether the snapshot has the tag, ignoring case.
+func HasTag(snapshot api.CharacterSnapshot, tag string) bool {
+	if snapshot.Tags == nil {
+		return false
+	}
+	for _, t := range *snapshot.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasItem(snapshot api.CharacterSnapshot, itemHash int64) bool {
+	for _, item := range snapshot.Loadout {
+		if item.ItemHash == itemHash {
+			return true
+		}
+	}
+	return false
+}
+
+func hasDamageType(snapshot api.CharacterSnapshot, damageType string) bool {
+	for _, item := range snapshot.Loadout {
+		damage := item.ItemProperties.BaseInfo.Damage
+		if damage != nil && strings.EqualFold(damage.DamageType, damageType) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesSearch(snapshot api.CharacterSnapshot, search string) bool {
+	search = strings.ToLower(strings.TrimSpace(search))
+	if strings.Contains(strings.ToLower(snapshot.Name), search) {
+		return true
+	}
+	if snapshot.Description != nil && strings.Contains(strings.ToLower(*snapshot.Description), search) {
+		return true
+	}
+	for _, item := range snapshot.Loadout {
+		if strings.Contains(strings.ToLower(item.Name), search) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package snapshot
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"strconv"
+	"strings"
+)
+
+const exoticTierType = 6
+
+type subclassInfo struct {
+	Element string
+	Class   string
+}
+
+// subclasses maps subclass item names to their element and class, used when the subclass
+// item doesn't carry a damage type of its own.
+var subclasses = map[string]subclassInfo{
+	"Gunslinger":        {Element: "Solar", Class: "Hunter"},
+	"Arcstrider":        {Element: "Arc", Class: "Hunter"},
+	"Nightstalker":      {Element: "Void", Class: "Hunter"},
+	"Revenant":          {Element: "Stasis", Class: "Hunter"},
+	"Threadrunner":      {Element: "Strand", Class: "Hunter"},
+	"Sunbreaker":        {Element: "Solar", Class: "Titan"},
+	"Striker":           {Element: "Arc", Class: "Titan"},
+	"Sentinel":          {Element: "Void", Class: "Titan"},
+	"Behemoth":          {Element: "Stasis", Class: "Titan"},
+	"Berserker":         {Element: "Strand", Class: "Titan"},
+	"Dawnblade":         {Element: "Solar", Class: "Warlock"},
+	"Stormcaller":       {Element: "Arc", Class: "Warlock"},
+	"Voidwalker":        {Element: "Void", Class: "Warlock"},
+	"Shadebinder":       {Element: "Stasis", Class: "Warlock"},
+	"Broodweaver":       {Element: "Strand", Class: "Warlock"},
+	"Prismatic":         {Element: "Prismatic"},
+	"Prismatic Hunter":  {Element: "Prismatic", Class: "Hunter"},
+	"Prismatic Titan":   {Element: "Prismatic", Class: "Titan"},
+	"Prismatic Warlock": {Element: "Prismatic", Class: "Warlock"},
+}
+
+var (
+	weaponBuckets = []int{destiny.Kinetic, destiny.Energy, destiny.Power}
+	armorBuckets  = []uint32{
+		destiny.HelmetArmor,
+		destiny.GauntletsArmor,
+		destiny.ChestArmor,
+		destiny.LegArmor,
+		destiny.ClassArmor,
+	}
+)
+
+// DescriptiveName builds a name from the loadout contents, e.g. "Solar Hunter: Ace of Spades + Fusion".
+// Exotics are named directly, other primaries use their shortened archetype. Power weapons and armor
+// are only included when exotic. Returns an empty string when nothing useful could be found.
+func DescriptiveName(loadout api.Loadout) string {
+	title := subclassTitle(loadout)
+
+	parts := make([]string, 0)
+	for _, bucket := range weaponBuckets {
+		item, ok := loadout[strconv.Itoa(bucket)]
+		if !ok {
+			continue
+		}
+		if isExotic(item) {
+			parts = append(parts, item.Name)
+			continue
+		}
+		if bucket == destiny.Power {
+			continue
+		}
+		archetype := shortArchetype(item.ItemProperties.BaseInfo.ItemTypeDisplayName)
+		if archetype == "" {
+			archetype = item.Name
+		}
+		if archetype != "" {
+			parts = append(parts, archetype)
+		}
+	}
+	for _, bucket := range armorBuckets {
+		item, ok := loadout[strconv.FormatUint(uint64(bucket), 10)]
+		if ok && isExotic(item) {
+			parts = append(parts, item.Name)
+		}
+	}
+
+	body := strings.Join(parts, " + ")
+	switch {
+	case title != "" && body != "":
+		return title + ": " + body
+	case title != "":
+		return title
+	default:
+		return body
+	}
+}
+
+// subclassTitle returns "<Element> <Class>" for the equipped subclass, e.g. "Solar Hunter".
+func subclassTitle(loadout api.Loadout) string {
+	item, ok := loadout[strconv.Itoa(destiny.SubClass)]
+	if !ok {
+		return ""
+	}
+	info := subclasses[item.Name]
+	if damage := item.ItemProperties.BaseInfo.Damage; damage != nil && damage.DamageType != "" {
+		info.Element = damage.DamageType
+	}
+	if info.Class == "" {
+		// Subclass items are typed as "Hunter Subclass", "Titan Subclass", etc.
+		info.Class = strings.TrimSpace(strings.TrimSuffix(item.ItemProperties.BaseInfo.ItemTypeDisplayName, "Subclass"))
+	}
+	return strings.TrimSpace(info.Element + " " + info.Class)
+}
+
+func isExotic(item api.ItemSnapshot) bool {
+	base := item.ItemProperties.BaseInfo
+	return base.TierType == exoticTierType || base.TierTypeName == "Exotic"
+}
+
+// shortArchetype drops the trailing "Rifle" so "Pulse Rifle" reads as "Pulse". Hand Cannons,
+// Shotguns, etc. are left as is.
+func shortArchetype(itemType string) string {
+	return strings.TrimSpace(strings.TrimSuffix(itemType, " Rifle"))
+}
@@ -0,0 +1,130 @@
+package snapshot
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func namingItem(name, itemType string, tierType int32) api.ItemSnapshot {
+	return api.ItemSnapshot{
+		Name: name,
+		ItemProperties: api.ItemProperties{BaseInfo: api.BaseItemInfo{
+			Name:                name,
+			ItemTypeDisplayName: itemType,
+			TierType:            tierType,
+		}},
+	}
+}
+
+func namingLoadout(items map[int]api.ItemSnapshot) api.Loadout {
+	result := make(api.Loadout, len(items))
+	for bucket, item := range items {
+		result[strconv.Itoa(bucket)] = item
+	}
+	return result
+}
+
+func TestDescriptiveName(t *testing.T) {
+	subclass := namingItem("Gunslinger", "Hunter Subclass", 0)
+	tests := []struct {
+		name    string
+		loadout api.Loadout
+		want    string
+	}{
+		{
+			name: "exotic and legendary weapons",
+			loadout: namingLoadout(map[int]api.ItemSnapshot{
+				destiny.SubClass: subclass,
+				destiny.Kinetic:  namingItem("Ace of Spades", "Hand Cannon", exoticTierType),
+				destiny.Energy:   namingItem("Main Ingredient", "Fusion Rifle", 5),
+				destiny.Power:    namingItem("Apex Predator", "Rocket Launcher", 5),
+			}),
+			want: "Solar Hunter: Ace of Spades + Fusion",
+		},
+		{
+			name: "exotic armor",
+			loadout: namingLoadout(map[int]api.ItemSnapshot{
+				destiny.SubClass:            subclass,
+				destiny.Kinetic:             namingItem("Rose", "Hand Cannon", 5),
+				int(destiny.HelmetArmor):    namingItem("Celestial Nighthawk", "Helmet", exoticTierType),
+				int(destiny.GauntletsArmor): namingItem("Legendary Grips", "Gauntlets", 5),
+			}),
+			want: "Solar Hunter: Hand Cannon + Celestial Nighthawk",
+		},
+		{
+			name: "missing archetype uses the item name",
+			loadout: namingLoadout(map[int]api.ItemSnapshot{
+				destiny.Kinetic: namingItem("Rose", "", 5),
+			}),
+			want: "Rose",
+		},
+		{
+			name: "subclass only",
+			loadout: namingLoadout(map[int]api.ItemSnapshot{
+				destiny.SubClass: namingItem("Unknown Subclass", "Titan Subclass", 0),
+			}),
+			want: "Titan",
+		},
+		{
+			name:    "empty loadout",
+			loadout: api.Loadout{},
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DescriptiveName(tt.loadout); got != tt.want {
+				t.Errorf("DescriptiveName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUniqueName(t *testing.T) {
+	loadout := namingLoadout(map[int]api.ItemSnapshot{
+		destiny.Kinetic: namingItem("Rose", "Hand Cannon", 5),
+	})
+	tests := []struct {
+		name     string
+		loadout  api.Loadout
+		style    api.SnapshotNameStyle
+		taken    map[string]bool
+		want     string
+		wantMeme bool
+	}{
+		{name: "descriptive", loadout: loadout, style: api.SnapshotNameStyleDescriptive, want: "Hand Cannon"},
+		{
+			name:    "descriptive collision",
+			loadout: loadout,
+			style:   api.SnapshotNameStyleDescriptive,
+			taken:   map[string]bool{"Hand Cannon": true},
+			want:    "Hand Cannon #2",
+		},
+		{
+			name:    "descriptive repeated collision",
+			loadout: loadout,
+			style:   api.SnapshotNameStyleDescriptive,
+			taken:   map[string]bool{"Hand Cannon": true, "Hand Cannon #2": true},
+			want:    "Hand Cannon #3",
+		},
+		{name: "meme", loadout: loadout, style: api.SnapshotNameStyleMeme, wantMeme: true},
+		{name: "descriptive without items falls back to meme", loadout: api.Loadout{}, style: api.SnapshotNameStyleDescriptive, wantMeme: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := uniqueName(tt.loadout, tt.style, tt.taken)
+			if tt.wantMeme {
+				if got == "" || got == DescriptiveName(tt.loadout) || strings.Contains(got, "#") {
+					t.Errorf("uniqueName() = %q, want a fresh PvP name", got)
+				}
+				return
+			}
+			if got != tt.want {
+				t.Errorf("uniqueName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,11 +2,15 @@ package snapshot
 
 import (
 	"oneTrick/services/aggregate"
+	"oneTrick/services/rollup"
 
 	"cloud.google.com/go/firestore"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"context"
+	"errors"
 	"fmt"
 	"oneTrick/api"
 	"oneTrick/generator"
@@ -21,9 +25,9 @@ import (
 // Service defines the interface for working with character snapshots and aggregates.
 type Service interface {
 
-	// Save saves a new snapshot for the specified character for a user. Returns the
-	// snapshot data on success and an error if the generating or save to the DB fails
-	Save(ctx context.Context, userID, membershipID, characterID string) (*api.CharacterSnapshot, error)
+	// Save saves a new snapshot for the specified character for a user. New snapshots are named using
+	// nameStyle. Returns the snapshot data on success and an error if the generating or save to the DB fails
+	Save(ctx context.Context, userID, membershipID, characterID string, nameStyle api.SnapshotNameStyle) (*api.CharacterSnapshot, error)
 
 	// GetAllByCharacter retrieves all snapshots for a given user and character.
 	// Snapshots are returned in reverse chronological order based on their timestamp.
@@ -31,6 +35,10 @@ type Service interface {
 	// Returns a slice of snapshots or an error if the operation fails.
 	GetAllByCharacter(ctx context.Context, userID string, characterID string) ([]api.CharacterSnapshot, error)
 
+	// Find retrieves the snapshots for a user and character that match the filter.
+	// Snapshots are returned in reverse chronological order based on their creation time.
+	Find(ctx context.Context, userID string, characterID string, filter Filter) ([]api.CharacterSnapshot, error)
+
 	// Get retrieves a specific snapshot for a given user, character, and snapshot ID.
 	// Takes a context, user ID, character ID, and snapshot ID as input.
 	// Returns the requested CharacterSnapshot or an error if the snapshot is not found or cannot be retrieved.
@@ -38,7 +46,8 @@ type Service interface {
 
 	GetAll(ctx context.Context) ([]api.CharacterSnapshot, error)
 
-	// GetByIDs retrieves multiple snapshots for a given list of snapshot IDs.
+	// GetByIDs retrieves multiple snapshots for a given list of snapshot IDs, following the order of snapshotIDs.
+	// Snapshots that no longer exist are skipped.
 	GetByIDs(ctx context.Context, snapshotIDs []string) ([]api.CharacterSnapshot, error)
 
 	// Merge merges two character snapshots identified by snapshotID and targetSnapshotID, storing the result in a new snapshot.
@@ -61,20 +70,22 @@ type service struct {
 	UserService      user.Service
 	D2Service        destiny.Service
 	aggregateService aggregate.Service
+	rollupService    rollup.Service
 }
 
 var _ Service = (*service)(nil)
 
-func NewService(db *firestore.Client, userService user.Service, d2Service destiny.Service, aggregateService aggregate.Service) Service {
+func NewService(db *firestore.Client, userService user.Service, d2Service destiny.Service, aggregateService aggregate.Service, rollupService rollup.Service) Service {
 	return &service{
 		DB:               db,
 		UserService:      userService,
 		D2Service:        d2Service,
 		aggregateService: aggregateService,
+		rollupService:    rollupService,
 	}
 }
 
-func (s *service) create(ctx context.Context, userID string, snapshot api.CharacterSnapshot) (*string, error) {
+func (s *service) create(ctx context.Context, userID string, snapshot api.CharacterSnapshot, nameStyle api.SnapshotNameStyle) (*string, error) {
 
 	if snapshot.Hash == "" {
 		hash, err := utils.HashMap(snapshot.Loadout)
@@ -97,7 +108,11 @@ func (s *service) create(ctx context.Context, userID string, snapshot api.Charac
 	snapshot.CreatedAt = now
 	snapshot.UpdatedAt = now
 	if snapshot.Name == "" {
-		snapshot.Name = generator.PVPName()
+		name, err := s.generateName(ctx, snapshot, nameStyle)
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Name = name
 	}
 	ref := s.DB.Collection(collection).NewDoc()
 	snapshot.ID = ref.ID
@@ -108,6 +123,35 @@ func (s *service) create(ctx context.Context, userID string, snapshot api.Charac
 	return s.createHistoryEntry(ctx, snapshot)
 }
 
+// generateName names a new snapshot using the requested style, making sure the name isn't
+// already used by another snapshot for the same character.
+func (s *service) generateName(ctx context.Context, snapshot api.CharacterSnapshot, nameStyle api.SnapshotNameStyle) (string, error) {
+	existing, err := s.GetAllByCharacter(ctx, snapshot.UserID, snapshot.CharacterID)
+	if err != nil {
+		return "", err
+	}
+	names := make(map[string]bool, len(existing))
+	for _, snap := range existing {
+		names[snap.Name] = true
+	}
+	return uniqueName(snapshot.Loadout, nameStyle, names), nil
+}
+
+// uniqueName names the loadout using the requested style, falling back to a PvP name when the
+// loadout can't be described, and numbering it when the name is already taken.
+func uniqueName(loadout api.Loadout, nameStyle api.SnapshotNameStyle, taken map[string]bool) string {
+	name := ""
+	if nameStyle == api.SnapshotNameStyleDescriptive {
+		name = DescriptiveName(loadout)
+	}
+	if name == "" {
+		name = generator.PVPName()
+	}
+	return generator.Unique(name, func(candidate string) bool {
+		return taken[candidate]
+	})
+}
+
 func (s *service) createHistoryEntry(ctx context.Context, og api.CharacterSnapshot) (*string, error) {
 	now := time.Now()
 	history := History{
@@ -153,6 +197,20 @@ func (s *service) GetAllByCharacter(ctx context.Context, userID string, characte
 	return snapshots, nil
 }
 
+func (s *service) Find(ctx context.Context, userID string, characterID string, filter Filter) ([]api.CharacterSnapshot, error) {
+	snapshots, err := s.GetAllByCharacter(ctx, userID, characterID)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]api.CharacterSnapshot, 0, len(snapshots))
+	for _, snap := range snapshots {
+		if filter.Matches(snap) {
+			results = append(results, snap)
+		}
+	}
+	return results, nil
+}
+
 func optionalGetByHash(db *firestore.Client, ctx context.Context, hash string) (*api.CharacterSnapshot, error) {
 	og := api.CharacterSnapshot{}
 	docs, err := db.Collection(collection).
@@ -172,6 +230,9 @@ func optionalGetByHash(db *firestore.Client, ctx context.Context, hash string) (
 func (s *service) Get(ctx context.Context, snapshotID string) (*api.CharacterSnapshot, error) {
 	var result *api.CharacterSnapshot
 	data, err := s.DB.Collection(collection).Doc(snapshotID).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, NotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -195,13 +256,12 @@ func (s *service) GetAll(ctx context.Context) ([]api.CharacterSnapshot, error) {
 }
 
 func (s *service) GetByIDs(ctx context.Context, snapshotIDs []string) ([]api.CharacterSnapshot, error) {
-	data, err := s.DB.Collection(collection).Where("id", "in", snapshotIDs).Documents(ctx).GetAll()
+	results, missing, err := utils.GetByIDs[api.CharacterSnapshot](ctx, s.DB, collection, snapshotIDs)
 	if err != nil {
 		return nil, err
 	}
-	results, err := utils.GetAllToStructs[api.CharacterSnapshot](data)
-	if err != nil {
-		return nil, err
+	if len(missing) > 0 {
+		log.Warn().Strs("snapshotIds", missing).Msg("snapshots not found")
 	}
 	return results, nil
 }
@@ -305,7 +365,7 @@ func (s *service) generateSnapshot(ctx context.Context, userID, membershipID, ch
 	}, nil
 }
 
-func (s *service) Save(ctx context.Context, userID, membershipID, characterID string) (*api.CharacterSnapshot, error) {
+func (s *service) Save(ctx context.Context, userID, membershipID, characterID string, nameStyle api.SnapshotNameStyle) (*api.CharacterSnapshot, error) {
 	data, err := s.generateSnapshot(ctx, userID, membershipID, characterID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build data: %w", err)
@@ -313,11 +373,11 @@ func (s *service) Save(ctx context.Context, userID, membershipID, characterID st
 	if data == nil {
 		return nil, fmt.Errorf("failed to generate snapshot")
 	}
-	_, err = s.create(ctx, userID, *data)
+	id, err := s.create(ctx, userID, *data, nameStyle)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create snapshot: %w", err)
 	}
-	return data, nil
+	return s.Get(ctx, *id)
 }
 
 func (s *service) Merge(ctx context.Context, targetSnapshotID, sourceSnapshotID string) (api.CharacterSnapshot, error) {
@@ -343,7 +403,7 @@ func (s *service) Merge(ctx context.Context, targetSnapshotID, sourceSnapshotID
 		return api.CharacterSnapshot{}, fmt.Errorf("snapshots cannot be merged")
 	}
 
-	aggs, err := s.aggregateService.BySnapshotID(ctx, sourceSnapshotID, nil)
+	aggs, err := s.aggregateService.BySnapshotID(ctx, sourceSnapshotID)
 	if err != nil {
 		return api.CharacterSnapshot{}, err
 	}
@@ -375,6 +435,17 @@ func (s *service) Merge(ctx context.Context, targetSnapshotID, sourceSnapshotID
 			return api.CharacterSnapshot{}, err
 		}
 	}
+	// Every game is re-linked before the rollups are updated. A moved game leaves the rollups stale, and the admin
+	// rebuild then counts every game under the target
+	for _, agg := range aggs {
+		err := s.rollupService.Apply(ctx, agg.ID, resultSnapshot.CharacterID)
+		if errors.Is(err, rollup.Stale) || errors.Is(err, rollup.NotFound) {
+			break
+		}
+		if err != nil {
+			log.Warn().Err(err).Str("aggregateID", agg.ID).Msg("failed to update rollups after merge")
+		}
+	}
 
 	return api.CharacterSnapshot{}, nil
 }
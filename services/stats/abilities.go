@@ -0,0 +1,52 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+)
+
+func (s *service) GetAbilityKills(aggs []api.Aggregate, characterID string) api.AbilityKills {
+	totals := api.RollupTotals{}
+	for _, agg := range aggs {
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		stats := destiny.WithAbilityKills(performance)
+		totals.Matches++
+		totals.Kills += destiny.PairValue(stats.Kills)
+		totals.GrenadeKills += destiny.PairValue(stats.GrenadeKills)
+		totals.MeleeKills += destiny.PairValue(stats.MeleeKills)
+		totals.SuperKills += destiny.PairValue(stats.SuperKills)
+		totals.AbilityKills += destiny.PairValue(stats.AbilityKills)
+	}
+	return toAbilityKills(totals)
+}
+
+func (s *service) GetAbilityKillsFromRollups(character api.StatsRollup, snapshots []api.StatsRollup, modes []int64) (api.AbilityKills, map[string]api.AbilityKills) {
+	results := make(map[string]api.AbilityKills, len(snapshots))
+	for _, r := range snapshots {
+		totals := bucketFor(r, modes).Totals
+		if r.SnapshotID == nil || totals.Matches == 0 {
+			continue
+		}
+		results[*r.SnapshotID] = toAbilityKills(totals)
+	}
+	return toAbilityKills(bucketFor(character, modes).Totals), results
+}
+
+func toAbilityKills(t api.RollupTotals) api.AbilityKills {
+	return api.AbilityKills{
+		Matches:      t.Matches,
+		Kills:        t.Kills,
+		GrenadeKills: t.GrenadeKills,
+		MeleeKills:   t.MeleeKills,
+		SuperKills:   t.SuperKills,
+		AbilityKills: t.AbilityKills,
+		GrenadeShare: destiny.Ratio(t.GrenadeKills, t.Kills),
+		MeleeShare:   destiny.Ratio(t.MeleeKills, t.Kills),
+		SuperShare:   destiny.Ratio(t.SuperKills, t.Kills),
+		AbilityShare: destiny.Ratio(t.AbilityKills, t.Kills),
+		TotalShare:   destiny.Ratio(t.GrenadeKills+t.MeleeKills+t.SuperKills+t.AbilityKills, t.Kills),
+	}
+}
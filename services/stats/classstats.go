@@ -0,0 +1,173 @@
+package stats
+
+import (
+	"cmp"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+	"strconv"
+)
+
+// DefaultClassStatMinimumMatches is the number of matches a tier needs before it can be recommended.
+const DefaultClassStatMinimumMatches = 10
+
+// tierStat is the running total of the matches played with a class stat in a single tier.
+type tierStat struct {
+	Games int
+	Total loadoutStat
+}
+
+func (t tierStat) add(totals api.RollupTotals) tierStat {
+	t.Games += totals.Matches
+	t.Total = t.Total.add(rollupSample(totals).Total)
+	return t
+}
+
+// classStatMode is the running total of a single mode, and of every class stat tier played in it.
+type classStatMode struct {
+	Activity string
+	Total    tierStat
+	Names    map[string]string
+	Tiers    map[string]map[int]tierStat
+}
+
+func (s *service) GetClassStatAnalysis(snapshots []api.CharacterSnapshot, rollups []api.StatsRollup, modes []int64, minimumMatches int) []api.ClassStatAnalysis {
+	snapshotByID := make(map[string]api.CharacterSnapshot, len(snapshots))
+	for _, snap := range snapshots {
+		snapshotByID[snap.ID] = snap
+	}
+
+	byMode := make(map[int64]*classStatMode)
+	for _, r := range rollups {
+		if r.SnapshotID == nil {
+			continue
+		}
+		snap, ok := snapshotByID[*r.SnapshotID]
+		if !ok || snap.Stats == nil || len(*snap.Stats) == 0 {
+			continue
+		}
+		for key, bucket := range r.Modes {
+			mode, err := strconv.ParseInt(key, 10, 64)
+			if err != nil || (len(modes) > 0 && !slices.Contains(modes, mode)) {
+				continue
+			}
+			m, ok := byMode[mode]
+			if !ok {
+				m = &classStatMode{
+					Names: make(map[string]string),
+					Tiers: make(map[string]map[int]tierStat),
+				}
+				byMode[mode] = m
+			}
+			if bucket.Activity != nil {
+				m.Activity = *bucket.Activity
+			}
+			m.Total = m.Total.add(bucket.Totals)
+			for hash, stat := range *snap.Stats {
+				if hash == destiny.PowerLevelStat {
+					continue
+				}
+				if _, ok := m.Tiers[hash]; !ok {
+					m.Names[hash] = stat.Name
+					m.Tiers[hash] = make(map[int]tierStat)
+				}
+				tier := int(stat.Value) / 10
+				m.Tiers[hash][tier] = m.Tiers[hash][tier].add(bucket.Totals)
+			}
+		}
+	}
+
+	results := make([]api.ClassStatAnalysis, 0, len(byMode))
+	for mode, m := range byMode {
+		results = append(results, classStatAnalysis(mode, m, minimumMatches))
+	}
+	slices.SortFunc(results, func(a, b api.ClassStatAnalysis) int {
+		if c := cmp.Compare(b.Matches, a.Matches); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ActivityHash, b.ActivityHash)
+	})
+	return results
+}
+
+func classStatAnalysis(mode int64, m *classStatMode, minimumMatches int) api.ClassStatAnalysis {
+	result := api.ClassStatAnalysis{
+		ActivityHash:   mode,
+		Mode:           m.Activity,
+		Matches:        m.Total.Games,
+		WinRate:        destiny.Ratio(m.Total.Total.Wins, m.Total.Games),
+		DeathsPerMatch: destiny.Ratio(m.Total.Total.Deaths, m.Total.Games),
+		Stats:          make([]api.ClassStatCorrelation, 0, len(m.Tiers)),
+	}
+	for hash, tiers := range m.Tiers {
+		correlation := api.ClassStatCorrelation{
+			StatHash: hash,
+			Name:     m.Names[hash],
+			Tiers:    make([]api.ClassStatTier, 0, len(tiers)),
+		}
+		for tier, t := range tiers {
+			correlation.Tiers = append(correlation.Tiers, api.ClassStatTier{
+				Tier:           tier,
+				MinValue:       tier * 10,
+				Matches:        t.Games,
+				Wins:           t.Total.Wins,
+				Kills:          t.Total.Kills,
+				Deaths:         t.Total.Deaths,
+				Kd:             getKD(t.Total.Kills, t.Total.Deaths),
+				WinRate:        destiny.Ratio(t.Total.Wins, t.Games),
+				DeathsPerMatch: destiny.Ratio(t.Total.Deaths, t.Games),
+			})
+		}
+		slices.SortFunc(correlation.Tiers, func(a, b api.ClassStatTier) int {
+			return cmp.Compare(a.Tier, b.Tier)
+		})
+		result.Stats = append(result.Stats, correlation)
+
+		// A stat only says something when it was played at more than one tier
+		qualified := slices.DeleteFunc(slices.Clone(correlation.Tiers), func(t api.ClassStatTier) bool {
+			return t.Matches < minimumMatches
+		})
+		if len(qualified) < 2 {
+			continue
+		}
+		for _, t := range qualified {
+			candidate := &api.ClassStatRecommendation{
+				StatHash:                 hash,
+				Name:                     correlation.Name,
+				Tier:                     t.Tier,
+				MinValue:                 t.MinValue,
+				Matches:                  t.Matches,
+				WinRate:                  t.WinRate,
+				WinRateLowerBound:        wilsonInterval(t.Wins, t.Matches).Lower,
+				DeathsPerMatch:           t.DeathsPerMatch,
+				WinRateDifference:        t.WinRate - result.WinRate,
+				DeathsPerMatchDifference: t.DeathsPerMatch - result.DeathsPerMatch,
+			}
+			if betterRecommendation(candidate, result.Recommendation) {
+				result.Recommendation = candidate
+			}
+		}
+	}
+	slices.SortFunc(result.Stats, func(a, b api.ClassStatCorrelation) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return result
+}
+
+// betterRecommendation reports whether the candidate beats the current recommendation: a higher win rate lower bound,
+// then fewer deaths per match, then the lower stat hash and tier so ties don't depend on map order.
+func betterRecommendation(candidate, current *api.ClassStatRecommendation) bool {
+	if current == nil {
+		return true
+	}
+	if c := cmp.Compare(candidate.WinRateLowerBound, current.WinRateLowerBound); c != 0 {
+		return c > 0
+	}
+	if c := cmp.Compare(candidate.DeathsPerMatch, current.DeathsPerMatch); c != 0 {
+		return c < 0
+	}
+	if c := cmp.Compare(candidate.StatHash, current.StatHash); c != 0 {
+		return c < 0
+	}
+	return candidate.Tier < current.Tier
+}
@@ -0,0 +1,27 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"testing"
+)
+
+func TestBetterRecommendation(t *testing.T) {
+	current := &api.ClassStatRecommendation{StatHash: "2", Tier: 5, WinRateLowerBound: 0.5, DeathsPerMatch: 10}
+	tests := []struct {
+		name      string
+		candidate api.ClassStatRecommendation
+		want      bool
+	}{
+		{"higher lower bound", api.ClassStatRecommendation{StatHash: "3", WinRateLowerBound: 0.6, DeathsPerMatch: 12}, true},
+		{"fewer deaths", api.ClassStatRecommendation{StatHash: "3", WinRateLowerBound: 0.5, DeathsPerMatch: 9}, true},
+		{"lower stat hash", api.ClassStatRecommendation{StatHash: "1", Tier: 9, WinRateLowerBound: 0.5, DeathsPerMatch: 10}, true},
+		{"higher stat hash", api.ClassStatRecommendation{StatHash: "3", Tier: 1, WinRateLowerBound: 0.5, DeathsPerMatch: 10}, false},
+		{"lower tier", api.ClassStatRecommendation{StatHash: "2", Tier: 4, WinRateLowerBound: 0.5, DeathsPerMatch: 10}, true},
+		{"same tier", *current, false},
+	}
+	for _, tt := range tests {
+		if got := betterRecommendation(&tt.candidate, current); got != tt.want {
+			t.Errorf("%s: betterRecommendation() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package stats
+
+import (
+	"math"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+)
+
+const (
+	// significanceLevel is the p-value below which a difference counts as significant.
+	significanceLevel = 0.05
+	// zPower80 is the z-score for 80% power, used to estimate how many games are still needed.
+	zPower80 = 0.8416
+)
+
+func (s *service) CompareLoadouts(a, b api.CharacterSnapshot, aggsA, aggsB []api.Aggregate, characterID string) api.LoadoutComparison {
+	gamesA := snapshotGames(aggsA, characterID, a.ID)
+	gamesB := snapshotGames(aggsB, characterID, b.ID)
+
+	totalA, totalB := loadoutStat{}, loadoutStat{}
+	for _, g := range gamesA {
+		totalA = totalA.add(g)
+	}
+	for _, g := range gamesB {
+		totalB = totalB.add(g)
+	}
+	baseline := lobbyStrength(totalA.add(totalB))
+	return api.LoadoutComparison{
+		A:              comparisonSide(a, totalA, len(gamesA), baseline),
+		B:              comparisonSide(b, totalB, len(gamesB), baseline),
+		Kd:             ratioTest(gamesA, gamesB, killsOf, deathsOf),
+		KillsPerMinute: ratioTest(gamesA, gamesB, killsOf, minutesOf),
+		WinRate:        proportionTest(totalA.Wins, len(gamesA), totalB.Wins, len(gamesB)),
+	}
+}
+
+// snapshotGames returns the character's games where they were linked to the snapshot.
+func snapshotGames(aggs []api.Aggregate, characterID, snapshotID string) []loadoutStat {
+	results := make([]loadoutStat, 0, len(aggs))
+	for _, agg := range aggs {
+		link, ok := agg.SnapshotLinks[characterID]
+		if !ok || link.SnapshotID == nil || *link.SnapshotID != snapshotID {
+			continue
+		}
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		results = append(results, withLobby(gameStat(performance.PlayerStats), agg, characterID))
+	}
+	return results
+}
+
+// comparisonSide totals one loadout's games. baseline is the average opponent strength over both loadouts,
+// which the side's K/D is adjusted against.
+func comparisonSide(snapshot api.CharacterSnapshot, total loadoutStat, games int, baseline *float64) api.ComparisonSide {
+	kd := getKD(total.Kills, total.Deaths)
+	side := api.ComparisonSide{
+		SnapshotID:     snapshot.ID,
+		Name:           snapshot.Name,
+		Matches:        games,
+		Kills:          total.Kills,
+		Deaths:         total.Deaths,
+		Assists:        total.Assists,
+		Wins:           total.Wins,
+		SecondsPlayed:  total.Seconds,
+		Kd:             kd,
+		Kda:            getKDA(total.Kills, total.Deaths, total.Assists),
+		WinRate:        destiny.Ratio(total.Wins, games),
+		KillsPerMinute: minutesRatio(total.Kills, total.Seconds),
+		LobbyStrength:  lobbyStrength(total),
+		AdjustedKd:     kd,
+	}
+	if side.LobbyStrength != nil && baseline != nil && *baseline > 0 {
+		side.AdjustedKd = kd * *side.LobbyStrength / *baseline
+	}
+	return side
+}
+
+// ratioTest compares a per game ratio, like K/D, between the two sets of games with a z-test on the
+// delta method standard errors.
+func ratioTest(a, b []loadoutStat, num, den func(loadoutStat) float64) api.ComparisonTest {
+	estimateA, seA := ratioEstimate(a, num, den)
+	estimateB, seB := ratioEstimate(b, num, den)
+	diff := estimateA - estimateB
+	result := api.ComparisonTest{Difference: diff, PValue: 1}
+	se := math.Sqrt(seA*seA + seB*seB)
+	if se == 0 {
+		return result
+	}
+	result.PValue = pValue(diff / se)
+	result.Significant = result.PValue < significanceLevel
+	// Per game variance of each side, so the games needed can be projected
+	variance := seA*seA*float64(len(a)) + seB*seB*float64(len(b))
+	result.AdditionalGames = additionalGames(result.Significant, diff, variance, min(len(a), len(b)))
+	return result
+}
+
+// proportionTest compares win rates with a two proportion z-test.
+func proportionTest(winsA, gamesA, winsB, gamesB int) api.ComparisonTest {
+	pA, pB := destiny.Ratio(winsA, gamesA), destiny.Ratio(winsB, gamesB)
+	diff := pA - pB
+	result := api.ComparisonTest{Difference: diff, PValue: 1}
+	if gamesA == 0 || gamesB == 0 {
+		return result
+	}
+	pooled := destiny.Ratio(winsA+winsB, gamesA+gamesB)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/float64(gamesA) + 1/float64(gamesB)))
+	if se == 0 {
+		return result
+	}
+	result.PValue = pValue(diff / se)
+	result.Significant = result.PValue < significanceLevel
+	variance := pA*(1-pA) + pB*(1-pB)
+	result.AdditionalGames = additionalGames(result.Significant, diff, variance, min(gamesA, gamesB))
+	return result
+}
+
+// additionalGames estimates how many more games each loadout needs for the difference to be
+// detected with 80% power, given the summed per game variance of both sides. Returns nil when
+// there is no difference to detect.
+func additionalGames(significant bool, diff, variance float64, played int) *int {
+	if significant {
+		return new(int)
+	}
+	if diff == 0 {
+		return nil
+	}
+	z := z95 + zPower80
+	needed := int(math.Ceil(z * z * variance / (diff * diff)))
+	result := max(1, needed-played)
+	return &result
+}
+
+// pValue returns the two-sided p-value of a z-score.
+func pValue(z float64) float64 {
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+func minutesRatio(count, seconds int) float64 {
+	if seconds == 0 {
+		return 0
+	}
+	return float64(count) / (float64(seconds) / 60)
+}
@@ -0,0 +1,29 @@
+package stats
+
+import "testing"
+
+func TestProportionTest(t *testing.T) {
+	t.Run("clear difference is significant", func(t *testing.T) {
+		got := proportionTest(70, 100, 40, 100)
+		if !got.Significant || got.AdditionalGames == nil || *got.AdditionalGames != 0 {
+			t.Errorf("proportionTest() = %+v, want significant with no additional games", got)
+		}
+	})
+
+	t.Run("small sample needs more games", func(t *testing.T) {
+		got := proportionTest(3, 5, 2, 5)
+		if got.Significant {
+			t.Fatalf("proportionTest() = %+v, want not significant", got)
+		}
+		if got.AdditionalGames == nil || *got.AdditionalGames <= 0 {
+			t.Errorf("proportionTest() additional games = %v, want a positive estimate", got.AdditionalGames)
+		}
+	})
+
+	t.Run("no difference", func(t *testing.T) {
+		got := proportionTest(5, 10, 5, 10)
+		if got.Significant || got.AdditionalGames != nil || !almostEqual(got.PValue, 1) {
+			t.Errorf("proportionTest() = %+v, want p-value 1 and no estimate", got)
+		}
+	})
+}
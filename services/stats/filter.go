@@ -0,0 +1,174 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+	"time"
+)
+
+// Filter narrows down the matches counted by the metrics. Empty or nil fields are ignored, and the list fields
+// match any of their values.
+type Filter struct {
+	// ActivityHashes are the playlists of the requested game mode, which also key the rollup mode buckets.
+	ActivityHashes []int64
+	ModeHashes     []int64
+	From           *time.Time
+	To             *time.Time
+	MapHashes      []int64
+	SessionIDs     []string
+	SnapshotIDs    []string
+	WeaponHashes   []int64
+	// Tags keep the matches linked to a snapshot with one of the tags. They are resolved to snapshots when the
+	// aggregates are fetched, Matches ignores them.
+	Tags             []string
+	MinimumSeconds   int
+	MinLobbyStrength *float64
+	MaxLobbyStrength *float64
+
+	tagged map[string]bool
+}
+
+// NewFilter builds the filter of a metrics request from the game mode's activities and the request's filter.
+func NewFilter(activities destiny.GameModeActivities, filter *api.StatsFilter) Filter {
+	result := Filter{ActivityHashes: activities.Hashes}
+	if filter == nil {
+		return result
+	}
+	result.From = filter.From
+	result.To = filter.To
+	result.ModeHashes = deref(filter.ModeHashes)
+	result.MapHashes = deref(filter.MapHashes)
+	result.SessionIDs = deref(filter.SessionIDs)
+	result.SnapshotIDs = deref(filter.SnapshotIDs)
+	result.WeaponHashes = deref(filter.WeaponHashes)
+	result.Tags = deref(filter.Tags)
+	if filter.MinimumSeconds != nil {
+		result.MinimumSeconds = *filter.MinimumSeconds
+	}
+	result.MinLobbyStrength = filter.MinLobbyStrength
+	result.MaxLobbyStrength = filter.MaxLobbyStrength
+	return result
+}
+
+// WithSnapshot returns the filter narrowed down to the matches linked to the snapshot.
+func (f Filter) WithSnapshot(snapshotID string) Filter {
+	if len(f.SnapshotIDs) > 0 && !slices.Contains(f.SnapshotIDs, snapshotID) {
+		// Neither snapshot can hold, keep a filter that matches nothing
+		f.SnapshotIDs = []string{""}
+		return f
+	}
+	f.SnapshotIDs = []string{snapshotID}
+	return f
+}
+
+// WithPeriod returns the filter narrowed down to the matches played within from and to. Nil bounds are ignored.
+func (f Filter) WithPeriod(from, to *time.Time) Filter {
+	if from != nil && (f.From == nil || from.After(*f.From)) {
+		f.From = from
+	}
+	if to != nil && (f.To == nil || to.Before(*f.To)) {
+		f.To = to
+	}
+	return f
+}
+
+// ByModeOnly reports whether the filter only narrows down by game mode, which the rollups can answer without
+// reading every aggregate.
+func (f Filter) ByModeOnly() bool {
+	return f.From == nil && f.To == nil && f.ByPeriodOnly()
+}
+
+// ByPeriodOnly reports whether the filter only narrows down by game mode and period.
+func (f Filter) ByPeriodOnly() bool {
+	return len(f.ModeHashes) == 0 && len(f.MapHashes) == 0 && len(f.SessionIDs) == 0 && len(f.SnapshotIDs) == 0 &&
+		len(f.WeaponHashes) == 0 && len(f.Tags) == 0 && f.MinimumSeconds == 0 && f.MinLobbyStrength == nil &&
+		f.MaxLobbyStrength == nil
+}
+
+// Period returns the filter narrowed down by game mode and period only.
+func (f Filter) Period() Filter {
+	return Filter{ActivityHashes: f.ActivityHashes, From: f.From, To: f.To}
+}
+
+// Matches reports whether the character's match satisfies every condition of the filter.
+func (f Filter) Matches(agg api.Aggregate, characterID string) bool {
+	details := agg.ActivityDetails
+	if len(f.ActivityHashes) > 0 && !slices.Contains(f.ActivityHashes, details.ActivityHash) {
+		return false
+	}
+	if len(f.ModeHashes) > 0 && !slices.Contains(f.ModeHashes, details.ActivityHash) {
+		return false
+	}
+	if f.From != nil && details.Period.Before(*f.From) {
+		return false
+	}
+	if f.To != nil && !details.Period.Before(*f.To) {
+		return false
+	}
+	if len(f.MapHashes) > 0 && !slices.Contains(f.MapHashes, details.ReferenceID) {
+		return false
+	}
+	if len(f.SessionIDs) > 0 && !slices.ContainsFunc(agg.SessionIds, func(id string) bool {
+		return slices.Contains(f.SessionIDs, id)
+	}) {
+		return false
+	}
+	snapshotID := linkedSnapshotID(agg, characterID)
+	if len(f.SnapshotIDs) > 0 && (snapshotID == "" || !slices.Contains(f.SnapshotIDs, snapshotID)) {
+		return false
+	}
+	if len(f.Tags) > 0 && !f.tagged[snapshotID] {
+		return false
+	}
+	performance, ok := agg.Performance[characterID]
+	if len(f.WeaponHashes) > 0 && (!ok || !usedWeapon(performance, f.WeaponHashes)) {
+		return false
+	}
+	if f.MinimumSeconds > 0 && (!ok || destiny.PairValue(performance.PlayerStats.TimePlayed) < f.MinimumSeconds) {
+		return false
+	}
+	if f.MinLobbyStrength != nil || f.MaxLobbyStrength != nil {
+		strength, ok := destiny.OpponentStrength(agg, characterID)
+		if !ok {
+			return false
+		}
+		if (f.MinLobbyStrength != nil && strength < *f.MinLobbyStrength) ||
+			(f.MaxLobbyStrength != nil && strength > *f.MaxLobbyStrength) {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply keeps the aggregates where the character's match satisfies the filter.
+func (f Filter) Apply(aggs []api.Aggregate, characterID string) []api.Aggregate {
+	return slices.DeleteFunc(aggs, func(agg api.Aggregate) bool {
+		return !f.Matches(agg, characterID)
+	})
+}
+
+// linkedSnapshotID returns the snapshot the character's match is linked to, or an empty string.
+func linkedSnapshotID(agg api.Aggregate, characterID string) string {
+	link, ok := agg.SnapshotLinks[characterID]
+	if !ok || link.SnapshotID == nil {
+		return ""
+	}
+	return *link.SnapshotID
+}
+
+func usedWeapon(performance api.InstancePerformance, hashes []int64) bool {
+	for _, weapon := range performance.Weapons {
+		if weapon.ReferenceID != nil && slices.Contains(hashes, *weapon.ReferenceID) {
+			return true
+		}
+	}
+	return false
+}
+
+func deref[T any](values *[]T) []T {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
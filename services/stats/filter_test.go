@@ -0,0 +1,74 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"testing"
+	"time"
+)
+
+func TestFilterMatches(t *testing.T) {
+	period := time.Date(2024, 3, 1, 20, 0, 0, 0, time.UTC)
+	snapshotID := "snap"
+	weaponHash := int64(42)
+	seconds := 600.0
+	agg := api.Aggregate{
+		ActivityDetails: api.ActivityHistory{
+			Activity:     "Control",
+			ActivityHash: 100,
+			ReferenceID:  200,
+			Period:       period,
+		},
+		SessionIds:    []string{"session"},
+		SnapshotLinks: map[string]api.SnapshotLink{"c": {SnapshotID: &snapshotID}},
+		Performance: map[string]api.InstancePerformance{
+			"c": {
+				PlayerStats: api.PlayerStats{TimePlayed: &api.StatsValuePair{Value: &seconds}},
+				Weapons:     map[string]api.WeaponInstanceMetrics{"42": {ReferenceID: &weaponHash}},
+			},
+		},
+	}
+	before := period.Add(-time.Hour)
+	tests := []struct {
+		name   string
+		filter Filter
+		want   bool
+	}{
+		{"empty", Filter{}, true},
+		{"game mode", Filter{ActivityHashes: []int64{99, 100}}, true},
+		{"other game mode", Filter{ActivityHashes: []int64{99}}, false},
+		{"mode hash", Filter{ModeHashes: []int64{100}}, true},
+		{"other mode hash", Filter{ModeHashes: []int64{101}}, false},
+		{"from", Filter{From: &before}, true},
+		{"to is exclusive", Filter{To: &period}, false},
+		{"map", Filter{MapHashes: []int64{1, 200}}, true},
+		{"other map", Filter{MapHashes: []int64{1}}, false},
+		{"session", Filter{SessionIDs: []string{"session"}}, true},
+		{"other session", Filter{SessionIDs: []string{"other"}}, false},
+		{"snapshot", Filter{}.WithSnapshot(snapshotID), true},
+		{"conflicting snapshots", Filter{SnapshotIDs: []string{"other"}}.WithSnapshot(snapshotID), false},
+		{"weapon", Filter{WeaponHashes: []int64{42}}, true},
+		{"other weapon", Filter{WeaponHashes: []int64{7}}, false},
+		{"tagged", Filter{Tags: []string{"pvp"}, tagged: map[string]bool{snapshotID: true}}, true},
+		{"untagged", Filter{Tags: []string{"pvp"}}, false},
+		{"time played", Filter{MinimumSeconds: 600}, true},
+		{"short game", Filter{MinimumSeconds: 601}, false},
+		{"lobby without estimate", Filter{MinLobbyStrength: &seconds}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.Matches(agg, "c"); got != tt.want {
+			t.Errorf("%s: Matches() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFilterByModeOnly(t *testing.T) {
+	f := NewFilter(destiny.GameModeActivities{Hashes: []int64{100}}, &api.StatsFilter{})
+	if !f.ByModeOnly() {
+		t.Errorf("ByModeOnly() = false, want true for an empty request filter")
+	}
+	from := time.Now()
+	if f.WithPeriod(&from, nil).ByModeOnly() {
+		t.Errorf("ByModeOnly() = true, want false with a date range")
+	}
+}
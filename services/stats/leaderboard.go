@@ -0,0 +1,79 @@
+package stats
+
+import (
+	"cmp"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+)
+
+// DefaultLeaderboardMinimumMatches is the number of matches a user needs in the window to be ranked.
+const DefaultLeaderboardMinimumMatches = 1
+
+// LeaderboardMember is a user ranked on a leaderboard with the matches of each of their characters.
+type LeaderboardMember struct {
+	UserID      string
+	DisplayName string
+	// Aggregates holds the matches in the window keyed by character ID.
+	Aggregates map[string][]api.Aggregate
+}
+
+func (s *service) GetLeaderboard(members []LeaderboardMember, metric api.LeaderboardMetric, weaponHash int64, minimumMatches int) []api.LeaderboardEntry {
+	results := make([]api.LeaderboardEntry, 0, len(members))
+	for _, member := range members {
+		var total loadoutStat
+		matches, weaponKills := 0, 0
+		for characterID, aggs := range member.Aggregates {
+			for _, agg := range aggs {
+				performance, ok := agg.Performance[characterID]
+				if !ok {
+					continue
+				}
+				total = total.add(gameStat(performance.PlayerStats))
+				matches++
+				for _, weapon := range performance.Weapons {
+					if weapon.ReferenceID != nil && *weapon.ReferenceID == weaponHash {
+						weaponKills += destiny.StatValue(weapon.Stats, destiny.WeaponKillsStat)
+					}
+				}
+			}
+		}
+		if matches == 0 || matches < minimumMatches {
+			continue
+		}
+		var value float64
+		switch metric {
+		case api.LeaderboardMetricWinRate:
+			value = destiny.Ratio(total.Wins, matches)
+		case api.LeaderboardMetricWeaponKills:
+			value = float64(weaponKills)
+		case api.LeaderboardMetricTrialsWins:
+			value = float64(total.Wins)
+		default:
+			value = getKD(total.Kills, total.Deaths)
+		}
+		results = append(results, api.LeaderboardEntry{
+			UserID:      member.UserID,
+			DisplayName: member.DisplayName,
+			Value:       value,
+			Matches:     matches,
+		})
+	}
+
+	slices.SortFunc(results, func(a, b api.LeaderboardEntry) int {
+		if c := cmp.Compare(b.Value, a.Value); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Matches, a.Matches); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.DisplayName, b.DisplayName)
+	})
+	for i := range results {
+		results[i].Rank = i + 1
+		if i > 0 && results[i].Value == results[i-1].Value {
+			results[i].Rank = results[i-1].Rank
+		}
+	}
+	return results
+}
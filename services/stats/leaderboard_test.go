@@ -0,0 +1,44 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/internal/fixtures"
+	"testing"
+)
+
+func leaderboardMatch(characterID string, kills, deaths float64, won bool) api.Aggregate {
+	return api.Aggregate{
+		Performance: map[string]api.InstancePerformance{
+			characterID: {PlayerStats: fixtures.PlayerStats(kills, deaths, won)},
+		},
+	}
+}
+
+func TestGetLeaderboard(t *testing.T) {
+	members := []LeaderboardMember{
+		{UserID: "a", DisplayName: "A", Aggregates: map[string][]api.Aggregate{
+			"a1": {leaderboardMatch("a1", 10, 5, true)},
+			"a2": {leaderboardMatch("a2", 10, 5, false)},
+		}},
+		{UserID: "b", DisplayName: "B", Aggregates: map[string][]api.Aggregate{
+			"b1": {leaderboardMatch("b1", 20, 5, true), leaderboardMatch("b1", 0, 5, true)},
+		}},
+		{UserID: "c", DisplayName: "C", Aggregates: map[string][]api.Aggregate{
+			"c1": {leaderboardMatch("c1", 30, 5, true)},
+		}},
+	}
+	s := &service{}
+
+	got := s.GetLeaderboard(members, api.LeaderboardMetricKd, 0, 1)
+	if len(got) != 3 || got[0].UserID != "c" || got[0].Rank != 1 {
+		t.Fatalf("GetLeaderboard() = %+v, want c first", got)
+	}
+	if got[1].UserID != "a" || got[2].UserID != "b" || got[1].Rank != 2 || got[2].Rank != 2 {
+		t.Errorf("GetLeaderboard() = %+v, want a and b tied at a K/D of 2", got)
+	}
+
+	got = s.GetLeaderboard(members, api.LeaderboardMetricWinRate, 0, 2)
+	if len(got) != 2 || got[0].UserID != "b" || !almostEqual(got[0].Value, 1) || !almostEqual(got[1].Value, 0.5) {
+		t.Errorf("GetLeaderboard() = %+v, want b then a, c left out with a single match", got)
+	}
+}
@@ -0,0 +1,25 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+)
+
+// withLobby adds the opponents' strength of the match to the character's game, when it was estimated.
+func withLobby(game loadoutStat, agg api.Aggregate, characterID string) loadoutStat {
+	if strength, ok := destiny.OpponentStrength(agg, characterID); ok {
+		game.Lobby = strength
+		game.Rated = 1
+	}
+	return game
+}
+
+// lobbyStrength returns the average opponent strength over the rated games of the total, or nil when
+// none were rated.
+func lobbyStrength(total loadoutStat) *float64 {
+	if total.Rated == 0 {
+		return nil
+	}
+	strength := total.Lobby / float64(total.Rated)
+	return &strength
+}
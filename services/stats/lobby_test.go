@@ -0,0 +1,52 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"testing"
+)
+
+func value(v float64) *api.StatsValuePair {
+	return &api.StatsValuePair{Value: &v}
+}
+
+func TestWithLobby(t *testing.T) {
+	t.Run("no estimate", func(t *testing.T) {
+		if got := withLobby(loadoutStat{}, api.Aggregate{}, "1"); got.Rated != 0 {
+			t.Errorf("withLobby() = %+v, want an unrated game without a lobby estimate", got)
+		}
+	})
+
+	t.Run("other teams", func(t *testing.T) {
+		agg := api.Aggregate{
+			Performance: map[string]api.InstancePerformance{
+				"1": {PlayerStats: api.PlayerStats{Team: value(17)}},
+			},
+			LobbyStrength: &api.LobbyStrength{
+				Players:    6,
+				Efficiency: 1.5,
+				Teams: map[string]api.TeamStrength{
+					"17": {Players: 3, Efficiency: 1},
+					"18": {Players: 3, Efficiency: 2},
+				},
+			},
+		}
+		got := withLobby(loadoutStat{}, agg, "1")
+		if got.Rated != 1 || !almostEqual(got.Lobby, 2) {
+			t.Errorf("withLobby() = %+v, want the other team's strength of 2", got)
+		}
+	})
+
+	t.Run("without teams excludes the character", func(t *testing.T) {
+		agg := api.Aggregate{
+			Performance: map[string]api.InstancePerformance{
+				"1": {PlayerStats: api.PlayerStats{Kills: value(6), Deaths: value(1)}},
+			},
+			LobbyStrength: &api.LobbyStrength{Players: 4, Efficiency: 3},
+		}
+		// (3 * 4 - 6) / 3
+		got := withLobby(loadoutStat{}, agg, "1")
+		if got.Rated != 1 || !almostEqual(got.Lobby, 2) {
+			t.Errorf("withLobby() = %+v, want the rest of the lobby's strength of 2", got)
+		}
+	})
+}
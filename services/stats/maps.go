@@ -0,0 +1,106 @@
+package stats
+
+import (
+	"cmp"
+	"context"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+)
+
+// mapStat is the running total of a character's games on a single map.
+type mapStat struct {
+	Performance api.MapPerformance
+	Total       loadoutStat
+	Games       []loadoutStat
+	Loadouts    map[string][]loadoutStat
+}
+
+func (s *service) GetMapPerformance(ctx context.Context, aggs []api.Aggregate, characterID string) ([]api.MapPerformance, error) {
+	maps := make(map[int64]*mapStat)
+	for _, agg := range aggs {
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		details := agg.ActivityDetails
+		m, ok := maps[details.ReferenceID]
+		if !ok {
+			m = &mapStat{
+				Performance: api.MapPerformance{
+					ReferenceID: details.ReferenceID,
+					Location:    details.Location,
+				},
+				Loadouts: make(map[string][]loadoutStat),
+			}
+			if details.ImageURL != "" {
+				m.Performance.ImageURL = &details.ImageURL
+			}
+			maps[details.ReferenceID] = m
+		}
+		game := gameStat(performance.PlayerStats)
+		m.Total = m.Total.add(game)
+		m.Games = append(m.Games, game)
+		if link, ok := agg.SnapshotLinks[characterID]; ok && link.SnapshotID != nil && *link.SnapshotID != "" {
+			m.Loadouts[*link.SnapshotID] = append(m.Loadouts[*link.SnapshotID], game)
+		}
+	}
+
+	snapshots, err := s.linkedSnapshots(ctx, aggs, characterID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]api.MapPerformance, 0, len(maps))
+	for _, m := range maps {
+		result := m.Performance
+		result.Matches = len(m.Games)
+		result.Wins = m.Total.Wins
+		result.Kills = m.Total.Kills
+		result.Deaths = m.Total.Deaths
+		result.Assists = m.Total.Assists
+		result.Kd = getKD(m.Total.Kills, m.Total.Deaths)
+		result.WinRate = destiny.Ratio(m.Total.Wins, len(m.Games))
+		result.BestLoadout = bestMapLoadout(m, snapshots)
+		results = append(results, result)
+	}
+	slices.SortFunc(results, func(a, b api.MapPerformance) int {
+		if c := cmp.Compare(b.Matches, a.Matches); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Location, b.Location)
+	})
+	return results, nil
+}
+
+// bestMapLoadout picks the loadout with the highest K/D on the map, shrunk toward the character's
+// average on that map. Returns nil when no games on the map were linked to a snapshot.
+func bestMapLoadout(m *mapStat, snapshots map[string]api.CharacterSnapshot) *api.MapLoadout {
+	p := prior{
+		Kills:  destiny.Ratio(m.Total.Kills, len(m.Games)),
+		Deaths: destiny.Ratio(m.Total.Deaths, len(m.Games)),
+	}
+	var (
+		best      *api.MapLoadout
+		bestScore float64
+	)
+	for id, games := range m.Loadouts {
+		total := loadoutStat{}
+		for _, g := range games {
+			total = total.add(g)
+		}
+		score := bayesianKD(total, p)
+		if best != nil && (score < bestScore || (score == bestScore && len(games) <= best.Matches)) {
+			continue
+		}
+		bestScore = score
+		best = &api.MapLoadout{
+			SnapshotID: id,
+			Name:       snapshots[id].Name,
+			Matches:    len(games),
+			Kd:         getKD(total.Kills, total.Deaths),
+			WinRate:    destiny.Ratio(total.Wins, len(games)),
+		}
+	}
+	return best
+}
@@ -0,0 +1,135 @@
+package stats
+
+import (
+	"cmp"
+	"context"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+)
+
+// cosmeticSocketTypes are socket plugs that don't change how a weapon plays, so they're left out of perk analytics.
+var cosmeticSocketTypes = map[string]bool{
+	"Shader":           true,
+	"Weapon Ornament":  true,
+	"Ornament":         true,
+	"Memento":          true,
+	"Tracker":          true,
+	"Kill Tracker":     true,
+	"Crucible Tracker": true,
+	"Empty Mod Socket": true,
+}
+
+// perkStat is the running total of matches played with a weapon that had a given perk.
+type perkStat struct {
+	Perk           api.PerkPerformance
+	Matches        int
+	Wins           int
+	Kills          int
+	Deaths         int
+	WeaponKills    int
+	PrecisionKills int
+}
+
+func (s *service) GetPerkPerformance(ctx context.Context, aggs []api.Aggregate, characterID string, weaponHash int64) ([]api.PerkPerformance, int, error) {
+	snapshots, err := s.linkedSnapshots(ctx, aggs, characterID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	matches := 0
+	totals := make(map[int64]*perkStat)
+	for _, agg := range aggs {
+		link, ok := agg.SnapshotLinks[characterID]
+		if !ok || link.SnapshotID == nil {
+			continue
+		}
+		item, ok := findItem(snapshots[*link.SnapshotID].Loadout, weaponHash)
+		if !ok {
+			continue
+		}
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		matches++
+
+		weaponKills, precisionKills := 0, 0
+		for _, metric := range performance.Weapons {
+			if metric.ReferenceID != nil && *metric.ReferenceID == weaponHash {
+				weaponKills = destiny.StatValue(metric.Stats, destiny.WeaponKillsStat)
+				precisionKills = destiny.StatValue(metric.Stats, destiny.WeaponPrecisionKillsStat)
+				break
+			}
+		}
+		for _, perk := range itemPerks(item.ItemProperties) {
+			total, ok := totals[perk.Hash]
+			if !ok {
+				total = &perkStat{Perk: perk}
+				totals[perk.Hash] = total
+			}
+			total.Matches++
+			if destiny.IsWin(performance.PlayerStats) {
+				total.Wins++
+			}
+			total.Kills += destiny.PairValue(performance.PlayerStats.Kills)
+			total.Deaths += destiny.PairValue(performance.PlayerStats.Deaths)
+			total.WeaponKills += weaponKills
+			total.PrecisionKills += precisionKills
+		}
+	}
+
+	results := make([]api.PerkPerformance, 0, len(totals))
+	for _, total := range totals {
+		result := total.Perk
+		result.Matches = total.Matches
+		result.Wins = total.Wins
+		result.Kills = total.Kills
+		result.Deaths = total.Deaths
+		result.WeaponKills = total.WeaponKills
+		result.PrecisionKills = total.PrecisionKills
+		result.Kd = getKD(total.Kills, total.Deaths)
+		result.PrecisionRate = destiny.Ratio(total.PrecisionKills, total.WeaponKills)
+		result.KillsPerMatch = destiny.Ratio(total.WeaponKills, total.Matches)
+		result.WinRate = destiny.Ratio(total.Wins, total.Matches)
+		results = append(results, result)
+	}
+	slices.SortFunc(results, func(a, b api.PerkPerformance) int {
+		if c := cmp.Compare(b.Matches, a.Matches); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return results, matches, nil
+}
+
+// itemPerks returns the perks that make up an item's roll. Sockets are preferred as they describe the
+// full roll, barrels and magazines included. Older snapshots without sockets fall back to the active perks.
+func itemPerks(properties api.ItemProperties) []api.PerkPerformance {
+	results := make([]api.PerkPerformance, 0)
+	if properties.Sockets != nil && len(*properties.Sockets) > 0 {
+		for _, socket := range *properties.Sockets {
+			if socket.Name == "" || (socket.IsEnabled != nil && !*socket.IsEnabled) {
+				continue
+			}
+			if socket.ItemTypeDisplayName != nil && cosmeticSocketTypes[*socket.ItemTypeDisplayName] {
+				continue
+			}
+			results = append(results, api.PerkPerformance{
+				Hash: int64(socket.PlugHash),
+				Name: socket.Name,
+				Type: socket.ItemTypeDisplayName,
+				Icon: socket.Icon,
+			})
+		}
+		return results
+	}
+	for _, perk := range properties.Perks {
+		results = append(results, api.PerkPerformance{
+			Hash: perk.Hash,
+			Name: perk.Name,
+			Icon: perk.IconPath,
+		})
+	}
+	return results
+}
@@ -0,0 +1,166 @@
+package stats
+
+import (
+	"math"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+)
+
+const (
+	// z95 is the z-score for a two-sided 95% confidence interval.
+	z95 = 1.96
+	// priorGames is how many average games of the character a loadout's K/D is shrunk with.
+	// The more games a loadout has, the less the prior matters.
+	priorGames = 10.0
+)
+
+// prior is the character's average kills and deaths per game across every match.
+type prior struct {
+	Kills  float64
+	Deaths float64
+}
+
+// rollupPrior averages the kills and deaths per game of the totals.
+func rollupPrior(t api.RollupTotals) prior {
+	return prior{
+		Kills:  destiny.Ratio(t.Kills, t.Matches),
+		Deaths: destiny.Ratio(t.Deaths, t.Matches),
+	}
+}
+
+// sample summarizes a set of games along with the sums needed for variance based statistics.
+type sample struct {
+	Games         int
+	Total         loadoutStat
+	KillsSquared  float64
+	DeathsSquared float64
+	KillsDeaths   float64
+}
+
+func newSample(games []loadoutStat) sample {
+	result := sample{Games: len(games)}
+	for _, g := range games {
+		result.Total = result.Total.add(g)
+		result.KillsSquared += float64(g.Kills * g.Kills)
+		result.DeathsSquared += float64(g.Deaths * g.Deaths)
+		result.KillsDeaths += float64(g.Kills * g.Deaths)
+	}
+	return result
+}
+
+func rollupSample(t api.RollupTotals) sample {
+	return sample{
+		Games: t.Matches,
+		Total: loadoutStat{
+			Kills:   t.Kills,
+			Deaths:  t.Deaths,
+			Assists: t.Assists,
+			Wins:    t.Wins,
+			Seconds: t.SecondsPlayed,
+		},
+		KillsSquared:  float64(t.KillsSquared),
+		DeathsSquared: float64(t.DeathsSquared),
+		KillsDeaths:   float64(t.KillsDeaths),
+	}
+}
+
+// bayesianKD shrinks the K/D toward the prior by adding priorGames of average games to the totals.
+func bayesianKD(total loadoutStat, p prior) float64 {
+	kills := float64(total.Kills) + priorGames*p.Kills
+	deaths := float64(total.Deaths) + priorGames*p.Deaths
+	if deaths == 0 {
+		return kills
+	}
+	return kills / deaths
+}
+
+// kdInterval estimates the 95% interval of the K/D. K/D is a ratio of two means, so its variance
+// is approximated with the delta method.
+func kdInterval(smp sample) api.ConfidenceInterval {
+	kd := getKD(smp.Total.Kills, smp.Total.Deaths)
+	result := api.ConfidenceInterval{Estimate: kd, Lower: kd, Upper: kd}
+	if smp.Games < 2 || smp.Total.Deaths == 0 {
+		return result
+	}
+	n := float64(smp.Games)
+	meanDeaths := float64(smp.Total.Deaths) / n
+	// Sum of (kills - kd * deaths)^2 over every game, expanded so it can be computed from the sums
+	residuals := math.Max(0, smp.KillsSquared-2*kd*smp.KillsDeaths+kd*kd*smp.DeathsSquared)
+	se := math.Sqrt(residuals/(n-1)/n) / meanDeaths
+	result.Lower = math.Max(0, kd-z95*se)
+	result.Upper = kd + z95*se
+	return result
+}
+
+// ratioEstimate returns the ratio of the totals of num and den over the games, and its standard error.
+// A ratio of two means isn't normally distributed, so the variance is approximated with the delta method.
+// The standard error is zero when there are fewer than two games or den totals zero.
+func ratioEstimate(games []loadoutStat, num, den func(loadoutStat) float64) (float64, float64) {
+	n := float64(len(games))
+	totalNum, totalDen := 0.0, 0.0
+	for _, g := range games {
+		totalNum += num(g)
+		totalDen += den(g)
+	}
+	if totalDen == 0 {
+		return totalNum, 0
+	}
+	estimate := totalNum / totalDen
+	if len(games) < 2 {
+		return estimate, 0
+	}
+
+	meanDen := totalDen / n
+	residuals := 0.0
+	for _, g := range games {
+		e := num(g) - estimate*den(g)
+		residuals += e * e
+	}
+	return estimate, math.Sqrt(residuals/(n-1)/n) / meanDen
+}
+
+func killsOf(g loadoutStat) float64   { return float64(g.Kills) }
+func deathsOf(g loadoutStat) float64  { return float64(g.Deaths) }
+func minutesOf(g loadoutStat) float64 { return float64(g.Seconds) / 60 }
+
+// wilsonInterval returns the Wilson score interval for wins out of games at 95% confidence.
+func wilsonInterval(wins, games int) api.ConfidenceInterval {
+	if games == 0 {
+		return api.ConfidenceInterval{}
+	}
+	n := float64(games)
+	p := float64(wins) / n
+	z2 := z95 * z95
+	center := (p + z2/(2*n)) / (1 + z2/n)
+	margin := z95 * math.Sqrt(p*(1-p)/n+z2/(4*n*n)) / (1 + z2/n)
+	return api.ConfidenceInterval{
+		Estimate: p,
+		Lower:    math.Max(0, center-margin),
+		Upper:    math.Min(1, center+margin),
+	}
+}
+
+// ratingScore ranks a loadout by its conservative rating, loadouts rated before ratings existed rank last.
+func ratingScore(rating *api.LoadoutRating) float64 {
+	if rating == nil {
+		return 0
+	}
+	return rating.Conservative
+}
+
+// loadoutConfidence scores the loadout's games for the ranking method and adds the confidence intervals.
+func loadoutConfidence(smp sample, p prior, ranking api.LoadoutRanking) api.LoadoutConfidence {
+	result := api.LoadoutConfidence{
+		Kd:      kdInterval(smp),
+		WinRate: wilsonInterval(smp.Total.Wins, smp.Games),
+	}
+	switch ranking {
+	case api.LoadoutRankingBayesianKd:
+		result.Score = bayesianKD(smp.Total, p)
+	case api.LoadoutRankingWinRateLowerBound:
+		result.Score = result.WinRate.Lower
+	default:
+		result.Score = result.Kd.Estimate
+	}
+	return result
+}
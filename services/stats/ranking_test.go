@@ -0,0 +1,67 @@
+package stats
+
+import (
+	"math"
+	"oneTrick/api"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-3
+}
+
+func TestWilsonInterval(t *testing.T) {
+	tests := []struct {
+		name         string
+		wins, games  int
+		lower, upper float64
+	}{
+		{name: "no games", wins: 0, games: 0, lower: 0, upper: 0},
+		{name: "half", wins: 50, games: 100, lower: 0.4038, upper: 0.5962},
+		{name: "all wins", wins: 5, games: 5, lower: 0.5655, upper: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := wilsonInterval(tt.wins, tt.games)
+			if !almostEqual(got.Lower, tt.lower) || !almostEqual(got.Upper, tt.upper) {
+				t.Errorf("wilsonInterval(%d, %d) = [%f, %f], want [%f, %f]", tt.wins, tt.games, got.Lower, got.Upper, tt.lower, tt.upper)
+			}
+		})
+	}
+}
+
+func TestBayesianKDPrefersLargerSamples(t *testing.T) {
+	p := prior{Kills: 10, Deaths: 10}
+	lucky := make([]loadoutStat, 0)
+	for i := 0; i < 5; i++ {
+		lucky = append(lucky, loadoutStat{Kills: 20, Deaths: 8})
+	}
+	solid := make([]loadoutStat, 0)
+	for i := 0; i < 200; i++ {
+		solid = append(solid, loadoutStat{Kills: 18, Deaths: 8})
+	}
+
+	raw := loadoutConfidence(newSample(lucky), p, api.LoadoutRankingKd).Score > loadoutConfidence(newSample(solid), p, api.LoadoutRankingKd).Score
+	if !raw {
+		t.Fatalf("expected the lucky loadout to have the higher raw K/D")
+	}
+	luckyScore := loadoutConfidence(newSample(lucky), p, api.LoadoutRankingBayesianKd).Score
+	solidScore := loadoutConfidence(newSample(solid), p, api.LoadoutRankingBayesianKd).Score
+	if luckyScore >= solidScore {
+		t.Errorf("bayesian K/D lucky = %f, solid = %f, want solid ranked higher", luckyScore, solidScore)
+	}
+}
+
+func TestKDInterval(t *testing.T) {
+	games := []loadoutStat{{Kills: 10, Deaths: 5}, {Kills: 20, Deaths: 10}}
+	got := kdInterval(newSample(games))
+	if !almostEqual(got.Estimate, 2) || !almostEqual(got.Lower, 2) || !almostEqual(got.Upper, 2) {
+		t.Errorf("kdInterval() = %+v, want a zero width interval at 2", got)
+	}
+
+	games = append(games, loadoutStat{Kills: 5, Deaths: 10})
+	got = kdInterval(newSample(games))
+	if got.Lower >= got.Estimate || got.Upper <= got.Estimate {
+		t.Errorf("kdInterval() = %+v, want the estimate inside a non empty interval", got)
+	}
+}
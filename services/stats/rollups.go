@@ -0,0 +1,83 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/rollup"
+	"strconv"
+)
+
+// bucketFor returns the rollup's totals across the given modes, or every mode when none are given.
+func bucketFor(r api.StatsRollup, modes []int64) api.RollupBucket {
+	if len(modes) == 0 {
+		return r.Overall
+	}
+	result := api.RollupBucket{Weapons: make(map[string]api.RollupWeapon)}
+	for _, mode := range modes {
+		bucket, ok := r.Modes[rollup.ModeKey(mode)]
+		if !ok {
+			continue
+		}
+		t := &result.Totals
+		t.Matches += bucket.Totals.Matches
+		t.Wins += bucket.Totals.Wins
+		t.Kills += bucket.Totals.Kills
+		t.Deaths += bucket.Totals.Deaths
+		t.Assists += bucket.Totals.Assists
+		t.SecondsPlayed += bucket.Totals.SecondsPlayed
+		t.KillsSquared += bucket.Totals.KillsSquared
+		t.DeathsSquared += bucket.Totals.DeathsSquared
+		t.KillsDeaths += bucket.Totals.KillsDeaths
+		t.GrenadeKills += bucket.Totals.GrenadeKills
+		t.MeleeKills += bucket.Totals.MeleeKills
+		t.SuperKills += bucket.Totals.SuperKills
+		t.AbilityKills += bucket.Totals.AbilityKills
+		for key, weapon := range bucket.Weapons {
+			w, ok := result.Weapons[key]
+			if !ok {
+				result.Weapons[key] = weapon
+				continue
+			}
+			w.Matches += weapon.Matches
+			w.Wins += weapon.Wins
+			w.Kills += weapon.Kills
+			w.PrecisionKills += weapon.PrecisionKills
+			result.Weapons[key] = w
+		}
+	}
+	return result
+}
+
+func (s *service) GetPerformanceFromRollups(snapshots []api.StatsRollup, modes []int64) (map[string]api.PlayerStats, map[string]int) {
+	results := make(map[string]api.PlayerStats, len(snapshots))
+	counts := make(map[string]int, len(snapshots))
+	for _, r := range snapshots {
+		totals := bucketFor(r, modes).Totals
+		if r.SnapshotID == nil || totals.Matches == 0 {
+			continue
+		}
+		results[*r.SnapshotID] = toPlayerStats(rollupSample(totals).Total, totals.Matches)
+		counts[*r.SnapshotID] = totals.Matches
+	}
+	return results, counts
+}
+
+func (s *service) GetWeaponPerformanceFromRollup(rollup api.StatsRollup, modes []int64) ([]api.WeaponPerformance, int) {
+	bucket := bucketFor(rollup, modes)
+	results := make([]api.WeaponPerformance, 0, len(bucket.Weapons))
+	for key, w := range bucket.Weapons {
+		referenceID := w.ReferenceID
+		if referenceID == 0 {
+			referenceID, _ = strconv.ParseInt(key, 10, 64)
+		}
+		results = append(results, toWeaponPerformance(weaponStat{
+			ReferenceID:    referenceID,
+			Display:        w.Display,
+			Matches:        w.Matches,
+			Wins:           w.Wins,
+			Kills:          w.Kills,
+			PrecisionKills: w.PrecisionKills,
+		}))
+	}
+	sortWeapons(results)
+	return results, bucket.Totals.Matches
+}
@@ -0,0 +1,48 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"time"
+)
+
+func (s *service) GetSchedule(aggs []api.Aggregate, characterID string, location *time.Location) api.Schedule {
+	hours := make([]loadoutStat, 24)
+	hourMatches := make([]int, 24)
+	weekdays := make([]loadoutStat, 7)
+	weekdayMatches := make([]int, 7)
+	for _, agg := range aggs {
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		game := gameStat(performance.PlayerStats)
+		local := agg.ActivityDetails.Period.In(location)
+		hours[local.Hour()] = hours[local.Hour()].add(game)
+		hourMatches[local.Hour()]++
+		weekdays[local.Weekday()] = weekdays[local.Weekday()].add(game)
+		weekdayMatches[local.Weekday()]++
+	}
+	return api.Schedule{
+		Timezone: location.String(),
+		Hours:    toScheduleBuckets(hours, hourMatches),
+		Weekdays: toScheduleBuckets(weekdays, weekdayMatches),
+	}
+}
+
+func toScheduleBuckets(totals []loadoutStat, matches []int) []api.ScheduleBucket {
+	results := make([]api.ScheduleBucket, len(totals))
+	for i, t := range totals {
+		results[i] = api.ScheduleBucket{
+			Bucket:  i,
+			Matches: matches[i],
+			Wins:    t.Wins,
+			Kills:   t.Kills,
+			Deaths:  t.Deaths,
+			Assists: t.Assists,
+			Kd:      getKD(t.Kills, t.Deaths),
+			WinRate: destiny.Ratio(t.Wins, matches[i]),
+		}
+	}
+	return results
+}
@@ -1,13 +1,16 @@
 package stats
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"oneTrick/api"
 	"oneTrick/ptr"
+	"oneTrick/services/destiny"
 	"oneTrick/services/snapshot"
 	"oneTrick/utils"
 	"slices"
+	"time"
 
 	"cloud.google.com/go/firestore"
 	"github.com/rs/zerolog/log"
@@ -17,11 +20,93 @@ import (
 // Note: "Loadout" in product language corresponds to a Snapshot in code.
 // This service focuses on aggregating data to support stats views for a user's loadouts.
 type Service interface {
-	GetAggregatesForSnapshot(ctx context.Context, snapshotID string, gameModeFilter []string) ([]api.Aggregate, error)
-	GetAggregatesByCharacterID(ctx context.Context, characterID string, gameModeFilter []string) ([]api.Aggregate, error)
-
-	GetMostUsedLoadouts(ctx context.Context, aggs []api.Aggregate, characterID string) ([]api.CharacterSnapshot, map[string]int, error)
-	GetBestPerformingLoadouts(ctx context.Context, aggs []api.Aggregate, characterID string, limit int8, minimumGames int) ([]api.CharacterSnapshot, map[string]api.PlayerStats, map[string]int, error)
+	// GetAggregatesForSnapshot returns the aggregates linked to the character's snapshot that match the filter.
+	GetAggregatesForSnapshot(ctx context.Context, characterID, snapshotID string, filter Filter) ([]api.Aggregate, error)
+	// GetAggregatesByCharacterID returns the character's aggregates that match the filter.
+	GetAggregatesByCharacterID(ctx context.Context, characterID string, filter Filter) ([]api.Aggregate, error)
+
+	// GetMostUsedLoadouts returns up to limit loadouts with the most games in counts, most used first.
+	// Returns the loadouts along with their stats and game counts, keyed by snapshot ID.
+	GetMostUsedLoadouts(ctx context.Context, performance map[string]api.PlayerStats, counts map[string]int, limit int) ([]api.CharacterSnapshot, map[string]api.PlayerStats, map[string]int, error)
+	// GetBestPerformingLoadouts ranks the snapshot rollups with at least minimumGames games in the given modes by the
+	// ranking method. The character rollup is used as the prior for Bayesian ranking.
+	// Returns the top loadouts in order along with their stats, game counts and confidence, keyed by snapshot ID.
+	GetBestPerformingLoadouts(ctx context.Context, character api.StatsRollup, snapshots []api.StatsRollup, modes []int64, limit int8, minimumGames int, ranking api.LoadoutRanking) ([]api.CharacterSnapshot, map[string]api.PlayerStats, map[string]int, map[string]api.LoadoutConfidence, error)
+
+	// GetPerformanceBySnapshot totals the character's stats across the aggregates for every linked snapshot.
+	// Returns the stats and the number of games played, both keyed by snapshot ID.
+	GetPerformanceBySnapshot(aggs []api.Aggregate, characterID string) (map[string]api.PlayerStats, map[string]int)
+
+	// GetPerformanceFromRollups is GetPerformanceBySnapshot read from snapshot rollups, limited to the given modes.
+	GetPerformanceFromRollups(snapshots []api.StatsRollup, modes []int64) (map[string]api.PlayerStats, map[string]int)
+
+	// GetWeaponPerformance totals the character's usage and performance per weapon, most kills first.
+	// A weapon counts as used in a match when it recorded a kill. When byInstance is set, weapons are
+	// split per instance ID using the loadout of the linked snapshot.
+	GetWeaponPerformance(ctx context.Context, aggs []api.Aggregate, characterID string, byInstance bool) ([]api.WeaponPerformance, error)
+
+	// GetWeaponPerformanceFromRollup is GetWeaponPerformance per item hash read from a rollup, limited to the
+	// given modes. Also returns the number of matches in those modes.
+	GetWeaponPerformanceFromRollup(rollup api.StatsRollup, modes []int64) ([]api.WeaponPerformance, int)
+
+	// GetPerkPerformance groups the matches the weapon was equipped in by each perk of the roll, using the
+	// loadout of the linked snapshot. Returns the results, largest sample first, and the number of matches used.
+	GetPerkPerformance(ctx context.Context, aggs []api.Aggregate, characterID string, weaponHash int64) ([]api.PerkPerformance, int, error)
+
+	// GetTrend builds a time series of the character's performance, oldest first. Matches are grouped by
+	// bucket, or with TrendBucketRolling, every window of matches becomes a point.
+	GetTrend(aggs []api.Aggregate, characterID string, bucket api.TrendBucket, window int) []api.TrendPoint
+
+	// GetMapPerformance totals the character's performance per map, most played first, along with the
+	// best linked loadout on each map.
+	GetMapPerformance(ctx context.Context, aggs []api.Aggregate, characterID string) ([]api.MapPerformance, error)
+
+	// CompareLoadouts compares the character's games with snapshot a against snapshot b, testing whether the
+	// differences in K/D, win rate and kills per minute are significant.
+	CompareLoadouts(a, b api.CharacterSnapshot, aggsA, aggsB []api.Aggregate, characterID string) api.LoadoutComparison
+
+	// GetTeammates groups the character's matches by the OneTrick characters they shared a fireteam with,
+	// keeping partners with at least minimumMatches together, most matches first. Also returns the totals
+	// for matches played without another OneTrick character in the fireteam.
+	GetTeammates(aggs []api.Aggregate, characterID string, minimumMatches int) ([]api.Teammate, api.FireteamStats)
+
+	// GetStreaks finds the character's win and loss streaks and whether the last window matches fell below
+	// threshold times the baseline K/D. The baseline is the character rollup's K/D across the modes the matches
+	// were played in, a nil character uses the K/D of the matches themselves.
+	GetStreaks(aggs []api.Aggregate, characterID string, character *api.StatsRollup, window int, threshold float64) api.StreakSummary
+
+	// GetStreaksByMode is GetStreaks for each activity mode the character played, each against the K/D of
+	// the mode's own matches.
+	GetStreaksByMode(aggs []api.Aggregate, characterID string, window int, threshold float64) map[string]api.StreakSummary
+
+	// GetClassStatAnalysis groups the snapshot rollups by the tier of each class stat the snapshot recorded,
+	// per mode, most played mode first. Only the given modes are included, or every mode when none are given.
+	GetClassStatAnalysis(snapshots []api.CharacterSnapshot, rollups []api.StatsRollup, modes []int64, minimumMatches int) []api.ClassStatAnalysis
+
+	// GetWeaponTypePerformance groups the weapons of the character rollup by archetype, damage type and tier,
+	// reading item details from the snapshots, and compares each group with the character's totals.
+	GetWeaponTypePerformance(rollup api.StatsRollup, snapshots []api.CharacterSnapshot, modes []int64) api.WeaponTypeBreakdown
+
+	// GetAbilityKills totals the character's grenade, melee, super and ability kills in the aggregates.
+	GetAbilityKills(aggs []api.Aggregate, characterID string) api.AbilityKills
+
+	// GetAbilityKillsFromRollups is GetAbilityKills for the character rollup and each snapshot rollup, keyed
+	// by snapshot ID, limited to the given modes.
+	GetAbilityKillsFromRollups(character api.StatsRollup, snapshots []api.StatsRollup, modes []int64) (api.AbilityKills, map[string]api.AbilityKills)
+
+	// GetSchedule buckets the character's matches by the hour of day and day of week they were played in location.
+	GetSchedule(aggs []api.Aggregate, characterID string, location *time.Location) api.Schedule
+
+	// GetLeaderboard ranks the members by the metric over all of their characters' matches, best first. Members
+	// with fewer than minimumMatches matches are left out, and tied members share a rank. weaponHash is the weapon
+	// counted by LeaderboardMetricWeaponKills.
+	GetLeaderboard(members []LeaderboardMember, metric api.LeaderboardMetric, weaponHash int64, minimumMatches int) []api.LeaderboardEntry
+
+	// GetTrialsCards groups the character's Trials matches into cards, returning the count newest ones along with
+	// the loadouts used on flawless and failed cards. The cards are built from every match in aggs, and only those
+	// with a match that keep keeps are returned and counted; a nil keep keeps them all. now decides whether the last
+	// card is still active.
+	GetTrialsCards(aggs []api.Aggregate, characterID string, keep func(api.Aggregate) bool, now time.Time, count int) api.TrialsCardSummary
 }
 
 type service struct {
@@ -39,71 +124,85 @@ const (
 	snapshotsCollection  = "snapshots"
 )
 
-func (s *service) GetAggregatesForSnapshot(ctx context.Context, snapshotID string, gameModeFilter []string) ([]api.Aggregate, error) {
+func (s *service) GetAggregatesForSnapshot(ctx context.Context, characterID, snapshotID string, filter Filter) ([]api.Aggregate, error) {
 	if snapshotID == "" {
 		return nil, fmt.Errorf("snapshotID is required")
 	}
-
 	q := s.DB.Collection(aggregatesCollection).
 		Where("snapshotIds", "array-contains", snapshotID)
-
-	if len(gameModeFilter) > 0 {
-		q = q.Where("activityHistory.mode", "in", gameModeFilter)
-	}
-
-	docs, err := q.Documents(ctx).GetAll()
-
-	if err != nil {
-		return nil, err
-	}
-	results, err := utils.GetAllToStructs[api.Aggregate](docs)
-	if err != nil {
-		return nil, err
-	}
-	return results, nil
+	return s.aggregates(ctx, q, characterID, filter)
 }
 
-func (s *service) GetAggregatesByCharacterID(ctx context.Context, characterID string, activityFilter []string) ([]api.Aggregate, error) {
+func (s *service) GetAggregatesByCharacterID(ctx context.Context, characterID string, filter Filter) ([]api.Aggregate, error) {
 	if characterID == "" {
 		return nil, fmt.Errorf("characterID is required")
 	}
 	q := s.DB.Collection(aggregatesCollection).
 		Where("characterIds", "array-contains", characterID)
+	return s.aggregates(ctx, q, characterID, filter)
+}
 
-	if len(activityFilter) > 0 {
-		q = q.Where("activityHistory.activity", "in", activityFilter)
+// aggregates runs the query narrowed down to the filter's game mode and period, and applies the rest of the filter
+// to the results so every endpoint counts the same matches.
+func (s *service) aggregates(ctx context.Context, q firestore.Query, characterID string, filter Filter) ([]api.Aggregate, error) {
+	if len(filter.ActivityHashes) > 0 && len(filter.ActivityHashes) <= utils.MaxInFilterValues {
+		// Game modes with more playlists than an `in` filter takes are narrowed down by Apply alone
+		q = q.Where("activityHistory.activityHash", "in", filter.ActivityHashes)
 	}
-
-	aggDocs, err := q.Documents(ctx).GetAll()
+	if filter.From != nil {
+		q = q.Where("activityHistory.period", ">=", *filter.From)
+	}
+	if filter.To != nil {
+		q = q.Where("activityHistory.period", "<", *filter.To)
+	}
+	docs, err := q.Documents(ctx).GetAll()
 	if err != nil {
 		return nil, err
 	}
-	aggs, err := utils.GetAllToStructs[api.Aggregate](aggDocs)
+	aggs, err := utils.GetAllToStructs[api.Aggregate](docs)
 	if err != nil {
 		return nil, err
 	}
-	return aggs, nil
-}
-
-// GetMostUsedLoadouts returns the top 10 most used loadouts for the given characterID.
-// Implementation details:
-// - This yields all activity aggregates where this character was linked to the specified snapshot (loadout).
-// - We then sort the results by the number of sessions (sessions.length) and return the top 10.
-func (s *service) GetMostUsedLoadouts(ctx context.Context, aggs []api.Aggregate, characterID string) ([]api.CharacterSnapshot, map[string]int, error) {
-	if characterID == "" {
-		return nil, nil, fmt.Errorf("characterID is required")
+	if len(filter.Tags) > 0 {
+		filter.tagged, err = s.taggedSnapshots(ctx, aggs, characterID, filter.Tags)
+		if err != nil {
+			return nil, err
+		}
 	}
+	return filter.Apply(aggs, characterID), nil
+}
 
-	counts := map[string]int{}
+// taggedSnapshots returns the snapshots linked to the character's matches that have one of the tags.
+func (s *service) taggedSnapshots(ctx context.Context, aggs []api.Aggregate, characterID string, tags []string) (map[string]bool, error) {
+	ids := make([]string, 0)
+	seen := make(map[string]bool)
 	for _, agg := range aggs {
-		link, ok := agg.SnapshotLinks[characterID]
-		if !ok || link.SnapshotID == nil || *link.SnapshotID == "" {
-			continue
+		if id := linkedSnapshotID(agg, characterID); id != "" && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
 		}
-		counts[*link.SnapshotID]++
 	}
+	result := make(map[string]bool)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	snapshots, err := s.snapshotService.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, snap := range snapshots {
+		for _, tag := range tags {
+			if snapshot.HasTag(snap, tag) {
+				result[snap.ID] = true
+				break
+			}
+		}
+	}
+	return result, nil
+}
 
-	// 3) Sort snapshot IDs by count desc and return the top 10
+func (s *service) GetMostUsedLoadouts(ctx context.Context, performance map[string]api.PlayerStats, counts map[string]int, limit int) ([]api.CharacterSnapshot, map[string]api.PlayerStats, map[string]int, error) {
+	// Sort snapshot IDs by count desc and return the top limit
 	type pair struct {
 		id    string
 		count int
@@ -120,23 +219,24 @@ func (s *service) GetMostUsedLoadouts(ctx context.Context, aggs []api.Aggregate,
 		return b.count - a.count
 	})
 
-	limit := 10
 	if len(pairs) < limit {
 		limit = len(pairs)
 	}
 
 	ids := make([]string, 0, limit)
 	finalCount := make(map[string]int)
+	finalPlayerStats := make(map[string]api.PlayerStats)
 	order := make(map[string]int, len(pairs))
 	for idx := 0; idx < limit; idx++ {
 		ids = append(ids, pairs[idx].id)
 		finalCount[pairs[idx].id] = pairs[idx].count
+		finalPlayerStats[pairs[idx].id] = performance[pairs[idx].id]
 		order[pairs[idx].id] = idx + 1
 	}
 
 	loadouts, err := s.snapshotService.GetByIDs(ctx, ids)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 	slices.SortFunc(loadouts, func(a, b api.CharacterSnapshot) int {
 		if order[a.ID] == order[b.ID] {
@@ -144,21 +244,39 @@ func (s *service) GetMostUsedLoadouts(ctx context.Context, aggs []api.Aggregate,
 		}
 		return order[a.ID] - order[b.ID]
 	})
-	return loadouts, finalCount, nil
+	return loadouts, finalPlayerStats, finalCount, nil
 }
 
-func (s *service) GetBestPerformingLoadouts(ctx context.Context, aggs []api.Aggregate, characterID string, limit int8, minimumGames int) ([]api.CharacterSnapshot, map[string]api.PlayerStats, map[string]int, error) {
-	if characterID == "" {
-		return nil, nil, nil, fmt.Errorf("characterID is required")
-	}
+// loadoutStat is the running total of a character's performance with a single loadout.
+type loadoutStat struct {
+	Kills   int
+	Deaths  int
+	Assists int
+	Wins    int
+	Seconds int
+	// Lobby is the sum of the opponents' strength over the Rated games that have a lobby estimate
+	Lobby float64
+	Rated int
+}
 
-	type stat struct {
-		Kills   int
-		Deaths  int
-		Assists int
-		Wins    int
+// gameStat returns the player's result in a single game.
+func gameStat(stats api.PlayerStats) loadoutStat {
+	result := loadoutStat{
+		Kills:   destiny.PairValue(stats.Kills),
+		Deaths:  destiny.PairValue(stats.Deaths),
+		Assists: destiny.PairValue(stats.Assists),
+		Seconds: destiny.PairValue(stats.TimePlayed),
+	}
+	if destiny.IsWin(stats) {
+		result.Wins = 1
 	}
-	stats := make(map[string]stat)
+	return result
+}
+
+// collectLoadoutStats totals the character's performance per linked snapshot, returning the totals
+// and the number of games played keyed by snapshot ID.
+func collectLoadoutStats(aggs []api.Aggregate, characterID string) (map[string]loadoutStat, map[string]int) {
+	stats := make(map[string]loadoutStat)
 	counts := make(map[string]int)
 	for _, agg := range aggs {
 		link, ok := agg.SnapshotLinks[characterID]
@@ -174,42 +292,90 @@ func (s *service) GetBestPerformingLoadouts(ctx context.Context, aggs []api.Aggr
 		s.Kills += int(*performance.PlayerStats.Kills.Value)
 		s.Deaths += int(*performance.PlayerStats.Deaths.Value)
 		s.Assists += int(*performance.PlayerStats.Assists.Value)
-		// Zero is a win in D2
-		if *performance.PlayerStats.Standing.Value == 0 {
+		if destiny.IsWin(performance.PlayerStats) {
 			s.Wins++
 		}
 		stats[*link.SnapshotID] = s
 		counts[*link.SnapshotID]++
 	}
+	return stats, counts
+}
+
+// toPlayerStats converts totals into PlayerStats. Standing holds the win rate over count games.
+func toPlayerStats(s loadoutStat, count int) api.PlayerStats {
+	return api.PlayerStats{
+		Assists: ptr.Of(api.StatsValuePair{
+			DisplayValue: ptr.Of(fmt.Sprintf("%d", s.Assists)),
+			Value:        ptr.Of(float64(s.Assists)),
+		}),
+		Deaths: ptr.Of(api.StatsValuePair{
+			DisplayValue: ptr.Of(fmt.Sprintf("%d", s.Deaths)),
+			Value:        ptr.Of(float64(s.Deaths)),
+		}),
+		Kills: ptr.Of(api.StatsValuePair{
+			DisplayValue: ptr.Of(fmt.Sprintf("%d", s.Kills)),
+			Value:        ptr.Of(float64(s.Kills)),
+		}),
+		Kd: ptr.Of(api.StatsValuePair{
+			DisplayValue: ptr.Of(fmt.Sprintf("%.2f", getKD(s.Kills, s.Deaths))),
+			Value:        ptr.Of(getKD(s.Kills, s.Deaths)),
+		}),
+		Kda: ptr.Of(api.StatsValuePair{
+			DisplayValue: ptr.Of(fmt.Sprintf("%.2f", getKDA(s.Kills, s.Deaths, s.Assists))),
+			Value:        ptr.Of(getKDA(s.Kills, s.Deaths, s.Assists)),
+		}),
+		Standing: ptr.Of(api.StatsValuePair{
+			DisplayValue: ptr.Of(fmt.Sprintf("%.2f", getKD(s.Wins, count))),
+			Value:        ptr.Of(getKD(s.Wins, count)),
+		}),
+	}
+}
 
-	// 3) Sort snapshot IDs by K/D and KD/A
+func (s *service) GetPerformanceBySnapshot(aggs []api.Aggregate, characterID string) (map[string]api.PlayerStats, map[string]int) {
+	stats, counts := collectLoadoutStats(aggs, characterID)
+	results := make(map[string]api.PlayerStats, len(stats))
+	for id, stat := range stats {
+		results[id] = toPlayerStats(stat, counts[id])
+	}
+	return results, counts
+}
+
+func (s *service) GetBestPerformingLoadouts(ctx context.Context, character api.StatsRollup, snapshots []api.StatsRollup, modes []int64, limit int8, minimumGames int, ranking api.LoadoutRanking) ([]api.CharacterSnapshot, map[string]api.PlayerStats, map[string]int, map[string]api.LoadoutConfidence, error) {
+	characterID := character.CharacterID
+	p := rollupPrior(bucketFor(character, modes).Totals)
+
+	// 3) Score every loadout with enough games and sort by the score of the ranking method
 	type pair struct {
-		id     string
-		stats  stat
-		counts int
+		id         string
+		stats      loadoutStat
+		counts     int
+		confidence api.LoadoutConfidence
 	}
-	pairs := make([]pair, 0, len(stats))
+	pairs := make([]pair, 0, len(snapshots))
 	log.Debug().Str("characterID", characterID).Int("Required Games Count", minimumGames).Msg("skipping loadout")
 	skipped := 0
-	for id, obj := range stats {
-		if counts[id] < minimumGames {
+	ratings := make(map[string]*api.LoadoutRating, len(snapshots))
+	for _, r := range snapshots {
+		totals := bucketFor(r, modes).Totals
+		if r.SnapshotID == nil || totals.Matches == 0 || totals.Matches < minimumGames {
 			skipped++
 			continue
 		}
-		pairs = append(pairs, pair{id: id, stats: obj, counts: counts[id]})
+		smp := rollupSample(totals)
+		confidence := loadoutConfidence(smp, p, ranking)
+		if ranking == api.LoadoutRankingRating {
+			confidence.Score = ratingScore(r.Rating)
+		}
+		ratings[*r.SnapshotID] = r.Rating
+		pairs = append(pairs, pair{id: *r.SnapshotID, stats: smp.Total, counts: smp.Games, confidence: confidence})
 	}
 	log.Debug().Int("skipped", skipped).Msg("loadouts skipped")
 
 	slices.SortFunc(pairs, func(a, b pair) int {
-		kda := getKD(a.stats.Kills, a.stats.Deaths)
-		kdb := getKD(b.stats.Kills, b.stats.Deaths)
-		if kda == kdb {
-			return 0
+		if c := cmp.Compare(b.confidence.Score, a.confidence.Score); c != 0 {
+			return c
 		}
-		if kda < kdb {
-			return 1
-		}
-		return -1
+		return cmp.Compare(b.counts, a.counts)
 	})
 
 	l := int(limit)
@@ -220,49 +386,27 @@ func (s *service) GetBestPerformingLoadouts(ctx context.Context, aggs []api.Aggr
 	ids := make([]string, 0, l)
 	finalPlayerStats := make(map[string]api.PlayerStats)
 	finalCount := make(map[string]int)
+	finalConfidence := make(map[string]api.LoadoutConfidence)
 	order := make(map[string]int, len(pairs))
 
 	for idx := 0; idx < l; idx++ {
 		ids = append(ids, pairs[idx].id)
-		count := pairs[idx].counts
 		finalCount[pairs[idx].id] = pairs[idx].counts
+		finalConfidence[pairs[idx].id] = pairs[idx].confidence
 		order[pairs[idx].id] = int(idx + 1)
-		s := pairs[idx].stats
-		finalPlayerStats[pairs[idx].id] = api.PlayerStats{
-			Assists: ptr.Of(api.StatsValuePair{
-				DisplayValue: ptr.Of(fmt.Sprintf("%d", s.Assists)),
-				Value:        ptr.Of(float64(s.Assists)),
-			}),
-			Deaths: ptr.Of(api.StatsValuePair{
-				DisplayValue: ptr.Of(fmt.Sprintf("%d", s.Deaths)),
-				Value:        ptr.Of(float64(s.Deaths)),
-			}),
-			Kills: ptr.Of(api.StatsValuePair{
-				DisplayValue: ptr.Of(fmt.Sprintf("%d", s.Kills)),
-				Value:        ptr.Of(float64(s.Kills)),
-			}),
-			Kd: ptr.Of(api.StatsValuePair{
-				DisplayValue: ptr.Of(fmt.Sprintf("%.2f", getKD(s.Kills, s.Deaths))),
-				Value:        ptr.Of(getKD(s.Kills, s.Deaths)),
-			}),
-			Kda: ptr.Of(api.StatsValuePair{
-				DisplayValue: ptr.Of(fmt.Sprintf("%.2f", getKDA(s.Kills, s.Deaths, s.Assists))),
-				Value:        ptr.Of(getKDA(s.Kills, s.Deaths, s.Assists)),
-			}),
-			Standing: ptr.Of(api.StatsValuePair{
-				DisplayValue: ptr.Of(fmt.Sprintf("%.2f", getKD(s.Wins, count))),
-				Value:        ptr.Of(getKD(s.Wins, count)),
-			}),
-		}
+		finalPlayerStats[pairs[idx].id] = toPlayerStats(pairs[idx].stats, pairs[idx].counts)
 	}
 
 	if len(ids) == 0 {
-		return nil, nil, nil, fmt.Errorf("no loadouts found")
+		return nil, nil, nil, nil, fmt.Errorf("no loadouts found")
 	}
 	loadouts, err := s.snapshotService.GetByIDs(ctx, ids)
 	if err != nil {
 		log.Error().Err(err).Msg("failed to get loadouts")
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
+	}
+	for i := range loadouts {
+		loadouts[i].Rating = ratings[loadouts[i].ID]
 	}
 	slices.SortFunc(loadouts, func(a, b api.CharacterSnapshot) int {
 		if order[a.ID] == order[b.ID] {
@@ -270,7 +414,7 @@ func (s *service) GetBestPerformingLoadouts(ctx context.Context, aggs []api.Aggr
 		}
 		return order[a.ID] - order[b.ID]
 	})
-	return loadouts, finalPlayerStats, finalCount, nil
+	return loadouts, finalPlayerStats, finalCount, finalConfidence, nil
 }
 
 func getKD(kills int, deaths int) float64 {
@@ -280,6 +424,14 @@ func getKD(kills int, deaths int) float64 {
 	return float64(kills) / float64(deaths)
 }
 
+// getBungieKDA follows Bungie's definition of KDA, where assists count for half a kill.
+func getBungieKDA(kills int, deaths int, assists int) float64 {
+	if deaths == 0 {
+		return float64(kills) + float64(assists)/2
+	}
+	return (float64(kills) + float64(assists)/2) / float64(deaths)
+}
+
 func getKDA(kills int, deaths int, assists int) float64 {
 	if deaths == 0 {
 		return float64(kills) + float64(assists)
@@ -0,0 +1,139 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"slices"
+	"time"
+)
+
+const (
+	// DefaultTiltWindow is the number of recent matches compared with the baseline when no window is given.
+	DefaultTiltWindow = 5
+	// DefaultTiltThreshold is the fraction of the baseline K/D the recent matches have to stay above.
+	DefaultTiltThreshold = 0.7
+)
+
+// streakMatch is a single match of the character, reduced to what's needed to find streaks.
+type streakMatch struct {
+	Period       time.Time
+	Mode         string
+	ActivityHash int64
+	Stat         loadoutStat
+}
+
+func (s *service) GetStreaks(aggs []api.Aggregate, characterID string, character *api.StatsRollup, window int, threshold float64) api.StreakSummary {
+	matches := streakMatches(aggs, characterID)
+	var baselineKD *float64
+	if character != nil {
+		totals := bucketFor(*character, streakModes(matches)).Totals
+		kd := getKD(totals.Kills, totals.Deaths)
+		baselineKD = &kd
+	}
+	return streakSummary(matches, baselineKD, window, threshold)
+}
+
+// streakModes returns the modes the matches were played in, so tilt is measured against the same modes.
+func streakModes(matches []streakMatch) []int64 {
+	modes := make([]int64, 0)
+	for _, m := range matches {
+		if !slices.Contains(modes, m.ActivityHash) {
+			modes = append(modes, m.ActivityHash)
+		}
+	}
+	return modes
+}
+
+func (s *service) GetStreaksByMode(aggs []api.Aggregate, characterID string, window int, threshold float64) map[string]api.StreakSummary {
+	modes := make(map[string][]streakMatch)
+	for _, m := range streakMatches(aggs, characterID) {
+		modes[m.Mode] = append(modes[m.Mode], m)
+	}
+	results := make(map[string]api.StreakSummary, len(modes))
+	for mode, matches := range modes {
+		results[mode] = streakSummary(matches, nil, window, threshold)
+	}
+	return results
+}
+
+// streakMatches returns the character's matches, oldest first.
+func streakMatches(aggs []api.Aggregate, characterID string) []streakMatch {
+	matches := make([]streakMatch, 0, len(aggs))
+	for _, agg := range aggs {
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		matches = append(matches, streakMatch{
+			Period:       agg.ActivityDetails.Period,
+			Mode:         agg.ActivityDetails.Activity,
+			ActivityHash: agg.ActivityDetails.ActivityHash,
+			Stat:         gameStat(performance.PlayerStats),
+		})
+	}
+	slices.SortFunc(matches, func(a, b streakMatch) int {
+		return a.Period.Compare(b.Period)
+	})
+	return matches
+}
+
+// streakSummary finds the streaks in the matches, which must be sorted oldest first. A nil baselineKD
+// measures tilt against the K/D of the matches themselves.
+func streakSummary(matches []streakMatch, baselineKD *float64, window int, threshold float64) api.StreakSummary {
+	result := api.StreakSummary{Matches: len(matches)}
+	var current *api.Streak
+	for _, m := range matches {
+		streakType := api.StreakLoss
+		if m.Stat.Wins > 0 {
+			streakType = api.StreakWin
+		}
+		if current == nil || current.Type != streakType {
+			current = &api.Streak{Type: streakType, Start: m.Period}
+		}
+		current.Length++
+		current.End = m.Period
+
+		longest := &result.LongestLoss
+		if streakType == api.StreakWin {
+			longest = &result.LongestWin
+		}
+		if *longest == nil || current.Length > (*longest).Length {
+			streak := *current
+			*longest = &streak
+		}
+	}
+	result.Current = current
+	result.Tilt = tiltStatus(matches, baselineKD, window, threshold)
+	return result
+}
+
+// tiltStatus compares the K/D of the last window matches with the baseline. The player is only tilted once
+// a full window has been played.
+func tiltStatus(matches []streakMatch, baselineKD *float64, window int, threshold float64) api.TiltStatus {
+	if window <= 0 {
+		window = DefaultTiltWindow
+	}
+	if threshold <= 0 {
+		threshold = DefaultTiltThreshold
+	}
+	recent := loadoutStat{}
+	total := loadoutStat{}
+	for i, m := range matches {
+		total = total.add(m.Stat)
+		if i >= len(matches)-window {
+			recent = recent.add(m.Stat)
+		}
+	}
+	baseline := getKD(total.Kills, total.Deaths)
+	if baselineKD != nil {
+		baseline = *baselineKD
+	}
+	result := api.TiltStatus{
+		Window:     window,
+		Matches:    min(window, len(matches)),
+		RecentKd:   getKD(recent.Kills, recent.Deaths),
+		BaselineKd: baseline,
+		Threshold:  threshold,
+	}
+	result.Tilted = result.Matches == window && result.RecentKd < threshold*baseline
+	return result
+}
@@ -0,0 +1,73 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"testing"
+	"time"
+)
+
+func TestStreakSummary(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	results := []bool{true, true, true, false, false, true, false}
+	matches := make([]streakMatch, 0, len(results))
+	for i, win := range results {
+		m := streakMatch{Period: start.Add(time.Duration(i) * time.Hour), Stat: loadoutStat{Kills: 10, Deaths: 5}}
+		if win {
+			m.Stat.Wins = 1
+		}
+		matches = append(matches, m)
+	}
+
+	got := streakSummary(matches, nil, 3, DefaultTiltThreshold)
+	if got.LongestWin == nil || got.LongestWin.Length != 3 || !got.LongestWin.End.Equal(matches[2].Period) {
+		t.Errorf("longest win = %+v, want 3 matches ending with the third", got.LongestWin)
+	}
+	if got.LongestLoss == nil || got.LongestLoss.Length != 2 {
+		t.Errorf("longest loss = %+v, want 2 matches", got.LongestLoss)
+	}
+	if got.Current == nil || got.Current.Type != api.StreakLoss || got.Current.Length != 1 {
+		t.Errorf("current = %+v, want a single loss", got.Current)
+	}
+	if got.Tilt.Tilted {
+		t.Errorf("tilt = %+v, want not tilted at a steady K/D", got.Tilt)
+	}
+}
+
+func TestTiltStatus(t *testing.T) {
+	matches := []streakMatch{
+		{Stat: loadoutStat{Kills: 20, Deaths: 10}},
+		{Stat: loadoutStat{Kills: 20, Deaths: 10}},
+		{Stat: loadoutStat{Kills: 5, Deaths: 10}},
+		{Stat: loadoutStat{Kills: 5, Deaths: 10}},
+	}
+	baseline := 2.0
+
+	if got := tiltStatus(matches, &baseline, 2, 0.7); !got.Tilted || !almostEqual(got.RecentKd, 0.5) {
+		t.Errorf("tiltStatus() = %+v, want tilted with a recent K/D of 0.5", got)
+	}
+	if got := tiltStatus(matches, &baseline, 5, 0.7); got.Tilted || got.Matches != 4 {
+		t.Errorf("tiltStatus() = %+v, want not tilted before a full window", got)
+	}
+}
+
+func TestGetStreaksBaselineModes(t *testing.T) {
+	// Trials at a 1.0 K/D shouldn't look tilted against a 3.0 overall K/D padded by Control, keyed 1 and 2
+	character := api.StatsRollup{
+		Overall: api.RollupBucket{Totals: api.RollupTotals{Kills: 400, Deaths: 200}},
+		Modes: map[string]api.RollupBucket{
+			"2": {Totals: api.RollupTotals{Kills: 300, Deaths: 100}},
+			"1": {Totals: api.RollupTotals{Kills: 100, Deaths: 100}},
+		},
+	}
+	aggs := []api.Aggregate{{
+		ActivityDetails: api.ActivityHistory{ActivityHash: 1, Activity: "Trials"},
+		Performance: map[string]api.InstancePerformance{
+			"c": {PlayerStats: api.PlayerStats{Kills: value(10), Deaths: value(10)}},
+		},
+	}}
+
+	got := (&service{}).GetStreaks(aggs, "c", &character, 1, DefaultTiltThreshold)
+	if !almostEqual(got.Tilt.BaselineKd, 1) || got.Tilt.Tilted {
+		t.Errorf("tilt = %+v, want measured against the Trials K/D of 1.0", got.Tilt)
+	}
+}
@@ -0,0 +1,90 @@
+package stats
+
+import (
+	"cmp"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+)
+
+// DefaultTeammateMinimumMatches is the number of matches together before a partner counts as frequent.
+const DefaultTeammateMinimumMatches = 2
+
+func (s *service) GetTeammates(aggs []api.Aggregate, characterID string, minimumMatches int) ([]api.Teammate, api.FireteamStats) {
+	var solo loadoutStat
+	soloMatches := 0
+	together := make(map[string]loadoutStat)
+	matches := make(map[string]int)
+	for _, agg := range aggs {
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		game := gameStat(performance.PlayerStats)
+		partners := fireteamPartners(agg, characterID)
+		if len(partners) == 0 {
+			solo = solo.add(game)
+			soloMatches++
+			continue
+		}
+		for _, partner := range partners {
+			together[partner] = together[partner].add(game)
+			matches[partner]++
+		}
+	}
+
+	results := make([]api.Teammate, 0, len(together))
+	for partner, total := range together {
+		if matches[partner] < minimumMatches {
+			continue
+		}
+		results = append(results, api.Teammate{
+			CharacterID: partner,
+			Together:    toFireteamStats(total, matches[partner]),
+		})
+	}
+	slices.SortFunc(results, func(a, b api.Teammate) int {
+		if c := cmp.Compare(b.Together.Matches, a.Together.Matches); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.CharacterID, b.CharacterID)
+	})
+	return results, toFireteamStats(solo, soloMatches)
+}
+
+// fireteamPartners returns the other OneTrick characters in the match that shared the character's fireteam.
+func fireteamPartners(agg api.Aggregate, characterID string) []string {
+	fireteam, ok := fireteamID(agg.Performance[characterID].PlayerStats)
+	if !ok {
+		return nil
+	}
+	var partners []string
+	for id, performance := range agg.Performance {
+		if id == characterID {
+			continue
+		}
+		if other, ok := fireteamID(performance.PlayerStats); ok && other == fireteam {
+			partners = append(partners, id)
+		}
+	}
+	return partners
+}
+
+func fireteamID(stats api.PlayerStats) (float64, bool) {
+	if stats.FireTeamID == nil || stats.FireTeamID.Value == nil {
+		return 0, false
+	}
+	return *stats.FireTeamID.Value, true
+}
+
+func toFireteamStats(total loadoutStat, matches int) api.FireteamStats {
+	return api.FireteamStats{
+		Matches: matches,
+		Wins:    total.Wins,
+		Kills:   total.Kills,
+		Deaths:  total.Deaths,
+		Assists: total.Assists,
+		Kd:      getKD(total.Kills, total.Deaths),
+		WinRate: destiny.Ratio(total.Wins, matches),
+	}
+}
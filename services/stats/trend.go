@@ -0,0 +1,165 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+	"time"
+)
+
+// DefaultTrendWindow is the number of matches in a rolling trend point when no window is given.
+const DefaultTrendWindow = 10
+
+// trendMatch is a single match of the character, reduced to what's needed to build a trend.
+type trendMatch struct {
+	Period    time.Time
+	SessionID string
+	Stat      loadoutStat
+}
+
+func (s *service) GetTrend(aggs []api.Aggregate, characterID string, bucket api.TrendBucket, window int) []api.TrendPoint {
+	matches := make([]trendMatch, 0, len(aggs))
+	for _, agg := range aggs {
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		m := trendMatch{
+			Period: agg.ActivityDetails.Period.UTC(),
+			Stat:   withLobby(gameStat(performance.PlayerStats), agg, characterID),
+		}
+		if link, ok := agg.SnapshotLinks[characterID]; ok && link.SessionID != nil {
+			m.SessionID = *link.SessionID
+		}
+		matches = append(matches, m)
+	}
+	slices.SortFunc(matches, func(a, b trendMatch) int {
+		return a.Period.Compare(b.Period)
+	})
+
+	switch bucket {
+	case api.TrendBucketRolling:
+		return rollingTrend(matches, window)
+	case api.TrendBucketSession:
+		return bucketTrend(matches, func(m trendMatch) (string, time.Time, time.Time, bool) {
+			return m.SessionID, time.Time{}, time.Time{}, m.SessionID != ""
+		})
+	case api.TrendBucketWeek:
+		return bucketTrend(matches, func(m trendMatch) (string, time.Time, time.Time, bool) {
+			day := startOfDay(m.Period)
+			start := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+			return start.Format(time.DateOnly), start, start.AddDate(0, 0, 7), true
+		})
+	default:
+		return bucketTrend(matches, func(m trendMatch) (string, time.Time, time.Time, bool) {
+			start := startOfDay(m.Period)
+			return start.Format(time.DateOnly), start, start.AddDate(0, 0, 1), true
+		})
+	}
+}
+
+// bucketTrend groups the matches, which must be sorted oldest first, with key. key returns the
+// bucket key, its bounds and whether the match belongs in any bucket. Zero bounds are replaced with
+// the period of the first and last match of the bucket.
+func bucketTrend(matches []trendMatch, key func(m trendMatch) (string, time.Time, time.Time, bool)) []api.TrendPoint {
+	results := make([]api.TrendPoint, 0)
+	totals := make(map[string]loadoutStat)
+	counts := make(map[string]int)
+	index := make(map[string]int)
+	for _, m := range matches {
+		k, start, end, ok := key(m)
+		if !ok {
+			continue
+		}
+		idx, seen := index[k]
+		if !seen {
+			idx = len(results)
+			index[k] = idx
+			point := api.TrendPoint{Start: start, End: end}
+			if start.IsZero() {
+				point.Start = m.Period
+				point.SessionID = &m.SessionID
+			}
+			results = append(results, point)
+		}
+		if end.IsZero() {
+			results[idx].End = m.Period
+		}
+		totals[k] = totals[k].add(m.Stat)
+		counts[k]++
+	}
+	for k, idx := range index {
+		fillTrendPoint(&results[idx], totals[k], counts[k])
+	}
+	slices.SortStableFunc(results, func(a, b api.TrendPoint) int {
+		return a.Start.Compare(b.Start)
+	})
+	return results
+}
+
+// rollingTrend returns a point for every window of matches, once enough matches have been played to fill it.
+func rollingTrend(matches []trendMatch, window int) []api.TrendPoint {
+	if window <= 0 {
+		window = DefaultTrendWindow
+	}
+	results := make([]api.TrendPoint, 0)
+	total := loadoutStat{}
+	for i, m := range matches {
+		total = total.add(m.Stat)
+		if i >= window {
+			total = total.sub(matches[i-window].Stat)
+		}
+		if i < window-1 {
+			continue
+		}
+		point := api.TrendPoint{
+			Start: matches[i-window+1].Period,
+			End:   m.Period,
+		}
+		fillTrendPoint(&point, total, window)
+		results = append(results, point)
+	}
+	return results
+}
+
+func fillTrendPoint(point *api.TrendPoint, s loadoutStat, count int) {
+	point.Matches = count
+	point.Kills = s.Kills
+	point.Deaths = s.Deaths
+	point.Assists = s.Assists
+	point.Wins = s.Wins
+	point.Kd = getKD(s.Kills, s.Deaths)
+	point.Kda = getKDA(s.Kills, s.Deaths, s.Assists)
+	point.BungieKda = getBungieKDA(s.Kills, s.Deaths, s.Assists)
+	point.Efficiency = getKDA(s.Kills, s.Deaths, s.Assists)
+	point.WinRate = destiny.Ratio(s.Wins, count)
+	point.LobbyStrength = lobbyStrength(s)
+}
+
+func (s loadoutStat) add(o loadoutStat) loadoutStat {
+	return loadoutStat{
+		Kills:   s.Kills + o.Kills,
+		Deaths:  s.Deaths + o.Deaths,
+		Assists: s.Assists + o.Assists,
+		Wins:    s.Wins + o.Wins,
+		Seconds: s.Seconds + o.Seconds,
+		Lobby:   s.Lobby + o.Lobby,
+		Rated:   s.Rated + o.Rated,
+	}
+}
+
+func (s loadoutStat) sub(o loadoutStat) loadoutStat {
+	return loadoutStat{
+		Kills:   s.Kills - o.Kills,
+		Deaths:  s.Deaths - o.Deaths,
+		Assists: s.Assists - o.Assists,
+		Wins:    s.Wins - o.Wins,
+		Seconds: s.Seconds - o.Seconds,
+		Lobby:   s.Lobby - o.Lobby,
+		Rated:   s.Rated - o.Rated,
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
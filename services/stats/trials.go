@@ -0,0 +1,169 @@
+package stats
+
+import (
+	"cmp"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+	"time"
+)
+
+const (
+	// DefaultTrialsCardCount is the number of cards returned in the history when no count is given.
+	DefaultTrialsCardCount = 20
+
+	trialsCardWins   = 7
+	trialsCardLosses = 3
+)
+
+// trialsCard is a card being put together, along with the matches played with each loadout on it. kept reports
+// whether one of its matches was kept.
+type trialsCard struct {
+	card     api.TrialsCard
+	loadouts map[string]loadoutStat
+	matches  map[string]int
+	kept     bool
+}
+
+func (s *service) GetTrialsCards(aggs []api.Aggregate, characterID string, keep func(api.Aggregate) bool, now time.Time, count int) api.TrialsCardSummary {
+	cards := slices.DeleteFunc(trialsCards(aggs, characterID, keep, now), func(c *trialsCard) bool {
+		return !c.kept
+	})
+	result := api.TrialsCardSummary{
+		Cards:            make([]api.TrialsCard, 0, min(count, len(cards))),
+		Total:            len(cards),
+		FlawlessLoadouts: make([]api.TrialsCardLoadout, 0),
+		FailedLoadouts:   make([]api.TrialsCardLoadout, 0),
+	}
+	flawless := make(map[string]*api.TrialsCardLoadout)
+	failed := make(map[string]*api.TrialsCardLoadout)
+	finished, wins := 0, 0
+	for _, c := range cards {
+		if c.card.Status == api.TrialsCardActive {
+			continue
+		}
+		finished++
+		wins += c.card.Wins
+		switch c.card.Status {
+		case api.TrialsCardFlawless:
+			result.Flawless++
+			addCardLoadouts(flawless, c)
+		case api.TrialsCardFailed:
+			addCardLoadouts(failed, c)
+		}
+	}
+	if finished > 0 {
+		result.FlawlessRate = float64(result.Flawless) / float64(finished)
+		result.AverageWins = float64(wins) / float64(finished)
+	}
+	for i := len(cards) - 1; i >= 0 && len(result.Cards) < count; i-- {
+		result.Cards = append(result.Cards, cards[i].card)
+	}
+	result.FlawlessLoadouts = sortedCardLoadouts(flawless)
+	result.FailedLoadouts = sortedCardLoadouts(failed)
+	return result
+}
+
+// trialsCards groups the character's matches into cards, oldest first. A card ends at seven wins or three losses,
+// and cards left open are reset by the weekly reset. The last card stays active until the next reset after now.
+// A card is kept when keep is nil or keeps one of its matches.
+func trialsCards(aggs []api.Aggregate, characterID string, keep func(api.Aggregate) bool, now time.Time) []*trialsCard {
+	sorted := slices.Clone(aggs)
+	slices.SortFunc(sorted, func(a, b api.Aggregate) int {
+		return a.ActivityDetails.Period.Compare(b.ActivityDetails.Period)
+	})
+	cards := make([]*trialsCard, 0)
+	var current *trialsCard
+	for _, agg := range sorted {
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		period := agg.ActivityDetails.Period
+		if current != nil && !destiny.WeeklyReset(period).Equal(destiny.WeeklyReset(current.card.Start)) {
+			current.card.Status = api.TrialsCardReset
+			current = nil
+		}
+		if current == nil {
+			current = &trialsCard{
+				card: api.TrialsCard{
+					Status:      api.TrialsCardActive,
+					Start:       period,
+					SnapshotIDs: make([]string, 0),
+				},
+				loadouts: make(map[string]loadoutStat),
+				matches:  make(map[string]int),
+			}
+			cards = append(cards, current)
+		}
+
+		current.kept = current.kept || keep == nil || keep(agg)
+		stat := gameStat(performance.PlayerStats)
+		card := &current.card
+		card.End = period
+		card.Kills += stat.Kills
+		card.Deaths += stat.Deaths
+		card.Kd = getKD(card.Kills, card.Deaths)
+		if stat.Wins > 0 {
+			card.Wins++
+		} else {
+			card.Losses++
+		}
+		if link, ok := agg.SnapshotLinks[characterID]; ok && link.SnapshotID != nil && *link.SnapshotID != "" {
+			snapshotID := *link.SnapshotID
+			if !slices.Contains(card.SnapshotIDs, snapshotID) {
+				card.SnapshotIDs = append(card.SnapshotIDs, snapshotID)
+			}
+			current.loadouts[snapshotID] = current.loadouts[snapshotID].add(stat)
+			current.matches[snapshotID]++
+		}
+
+		switch {
+		case card.Wins >= trialsCardWins && card.Losses == 0:
+			card.Status = api.TrialsCardFlawless
+		case card.Wins >= trialsCardWins:
+			card.Status = api.TrialsCardComplete
+		case card.Losses >= trialsCardLosses:
+			card.Status = api.TrialsCardFailed
+		}
+		if card.Status != api.TrialsCardActive {
+			current = nil
+		}
+	}
+	if current != nil && !destiny.WeeklyReset(now).Equal(destiny.WeeklyReset(current.card.Start)) {
+		current.card.Status = api.TrialsCardReset
+	}
+	return cards
+}
+
+// addCardLoadouts counts the card towards each loadout used on it.
+func addCardLoadouts(loadouts map[string]*api.TrialsCardLoadout, c *trialsCard) {
+	for snapshotID, stat := range c.loadouts {
+		loadout, ok := loadouts[snapshotID]
+		if !ok {
+			loadout = &api.TrialsCardLoadout{SnapshotID: snapshotID}
+			loadouts[snapshotID] = loadout
+		}
+		loadout.Cards++
+		loadout.Matches += c.matches[snapshotID]
+		loadout.Wins += stat.Wins
+	}
+}
+
+// sortedCardLoadouts sorts the loadouts by the cards they were used on, then by matches.
+func sortedCardLoadouts(loadouts map[string]*api.TrialsCardLoadout) []api.TrialsCardLoadout {
+	results := make([]api.TrialsCardLoadout, 0, len(loadouts))
+	for _, loadout := range loadouts {
+		results = append(results, *loadout)
+	}
+	slices.SortFunc(results, func(a, b api.TrialsCardLoadout) int {
+		if c := cmp.Compare(b.Cards, a.Cards); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Matches, a.Matches); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.SnapshotID, b.SnapshotID)
+	})
+	return results
+}
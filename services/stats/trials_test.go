@@ -0,0 +1,76 @@
+package stats
+
+import (
+	"oneTrick/api"
+	"oneTrick/internal/fixtures"
+	"testing"
+	"time"
+)
+
+func trialsMatch(period time.Time, snapshotID string, won bool) api.Aggregate {
+	return api.Aggregate{
+		ActivityDetails: api.ActivityHistory{Period: period},
+		Performance:     map[string]api.InstancePerformance{"c": {PlayerStats: fixtures.PlayerStats(10, 5, won)}},
+		SnapshotLinks:   map[string]api.SnapshotLink{"c": {SnapshotID: &snapshotID}},
+	}
+}
+
+func TestGetTrialsCards(t *testing.T) {
+	// Friday after the reset of Tuesday the 2nd
+	friday := time.Date(2024, 1, 5, 18, 0, 0, 0, time.UTC)
+	aggs := make([]api.Aggregate, 0)
+	add := func(snapshotID string, results ...bool) {
+		for _, won := range results {
+			aggs = append(aggs, trialsMatch(friday.Add(time.Duration(len(aggs))*time.Hour), snapshotID, won))
+		}
+	}
+	add("a", true, true, true, true, true, true, true)
+	add("b", true, false, false)
+	add("a", false)
+	add("b", true, true)
+	// Next weekend, the open card above is reset
+	friday = friday.Add(7 * 24 * time.Hour)
+	aggs = append(aggs, trialsMatch(friday.Add(10*time.Hour), "a", true))
+	s := &service{}
+
+	got := s.GetTrialsCards(aggs, "c", nil, friday.Add(11*time.Hour), DefaultTrialsCardCount)
+	if got.Total != 4 || got.Flawless != 1 {
+		t.Fatalf("GetTrialsCards() total, flawless = %d, %d, want 4, 1", got.Total, got.Flawless)
+	}
+	statuses := []api.TrialsCardStatus{api.TrialsCardActive, api.TrialsCardReset, api.TrialsCardFailed, api.TrialsCardFlawless}
+	for i, status := range statuses {
+		if got.Cards[i].Status != status {
+			t.Errorf("GetTrialsCards() card %d = %+v, want %s", i, got.Cards[i], status)
+		}
+	}
+	if got.Cards[2].Wins != 1 || got.Cards[2].Losses != 3 || len(got.Cards[2].SnapshotIDs) != 2 {
+		t.Errorf("GetTrialsCards() failed card = %+v, want 1-3 with both loadouts", got.Cards[2])
+	}
+	if !almostEqual(got.FlawlessRate, 1.0/3) || !almostEqual(got.AverageWins, 10.0/3) {
+		t.Errorf("GetTrialsCards() flawless rate, average wins = %v, %v, want 1/3, 10/3", got.FlawlessRate, got.AverageWins)
+	}
+	if len(got.FlawlessLoadouts) != 1 || got.FlawlessLoadouts[0].SnapshotID != "a" || got.FlawlessLoadouts[0].Matches != 7 {
+		t.Errorf("GetTrialsCards() flawless loadouts = %+v, want a over 7 matches", got.FlawlessLoadouts)
+	}
+	if len(got.FailedLoadouts) != 2 || got.FailedLoadouts[0].SnapshotID != "b" || got.FailedLoadouts[0].Wins != 1 {
+		t.Errorf("GetTrialsCards() failed loadouts = %+v, want b first", got.FailedLoadouts)
+	}
+
+	got = s.GetTrialsCards(aggs, "c", nil, friday.Add(8*24*time.Hour), 1)
+	if len(got.Cards) != 1 || got.Cards[0].Status != api.TrialsCardReset || got.Total != 4 {
+		t.Errorf("GetTrialsCards() after the next reset = %+v, want a single reset card out of 4", got.Cards)
+	}
+
+	// Keeping the matches with b picks the cards it was used on, which are still built from every match
+	withB := func(agg api.Aggregate) bool { return linkedSnapshotID(agg, "c") == "b" }
+	got = s.GetTrialsCards(aggs, "c", withB, friday.Add(11*time.Hour), DefaultTrialsCardCount)
+	if got.Total != 2 || got.Flawless != 0 || len(got.Cards) != 2 {
+		t.Fatalf("GetTrialsCards() with b total, flawless = %d, %d, want 2, 0", got.Total, got.Flawless)
+	}
+	if got.Cards[1].Status != api.TrialsCardFailed || got.Cards[1].Wins != 1 || got.Cards[1].Losses != 3 {
+		t.Errorf("GetTrialsCards() with b failed card = %+v, want the whole 1-3 card", got.Cards[1])
+	}
+	if len(got.FailedLoadouts) != 2 {
+		t.Errorf("GetTrialsCards() with b failed loadouts = %+v, want both loadouts of the card", got.FailedLoadouts)
+	}
+}
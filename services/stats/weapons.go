@@ -0,0 +1,139 @@
+package stats
+
+import (
+	"cmp"
+	"context"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+	"strconv"
+)
+
+// weaponStat is the running total for a single weapon, or weapon instance.
+type weaponStat struct {
+	ReferenceID    int64
+	InstanceID     string
+	Display        *api.Display
+	Matches        int
+	Wins           int
+	Kills          int
+	PrecisionKills int
+}
+
+func (s *service) GetWeaponPerformance(ctx context.Context, aggs []api.Aggregate, characterID string, byInstance bool) ([]api.WeaponPerformance, error) {
+	// Instance IDs aren't part of the PGCR, so they're read from the loadout of the linked snapshot.
+	snapshots := map[string]api.CharacterSnapshot{}
+	if byInstance {
+		var err error
+		snapshots, err = s.linkedSnapshots(ctx, aggs, characterID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	totals := make(map[string]*weaponStat)
+	for _, agg := range aggs {
+		performance, ok := agg.Performance[characterID]
+		if !ok {
+			continue
+		}
+		won := destiny.IsWin(performance.PlayerStats)
+		snapshotID := ""
+		if link, ok := agg.SnapshotLinks[characterID]; ok && link.SnapshotID != nil {
+			snapshotID = *link.SnapshotID
+		}
+		for _, metric := range performance.Weapons {
+			if metric.ReferenceID == nil {
+				continue
+			}
+			instanceID := ""
+			if item, ok := findItem(snapshots[snapshotID].Loadout, *metric.ReferenceID); ok {
+				instanceID = item.InstanceID
+			}
+			key := strconv.FormatInt(*metric.ReferenceID, 10) + ":" + instanceID
+			total, ok := totals[key]
+			if !ok {
+				total = &weaponStat{ReferenceID: *metric.ReferenceID, InstanceID: instanceID}
+				totals[key] = total
+			}
+			if total.Display == nil {
+				total.Display = metric.Display
+			}
+			total.Matches++
+			if won {
+				total.Wins++
+			}
+			total.Kills += destiny.StatValue(metric.Stats, destiny.WeaponKillsStat)
+			total.PrecisionKills += destiny.StatValue(metric.Stats, destiny.WeaponPrecisionKillsStat)
+		}
+	}
+
+	results := make([]api.WeaponPerformance, 0, len(totals))
+	for _, total := range totals {
+		results = append(results, toWeaponPerformance(*total))
+	}
+	sortWeapons(results)
+	return results, nil
+}
+
+// linkedSnapshots fetches every snapshot linked to the character in the aggregates, keyed by snapshot ID.
+func (s *service) linkedSnapshots(ctx context.Context, aggs []api.Aggregate, characterID string) (map[string]api.CharacterSnapshot, error) {
+	ids := make([]string, 0)
+	for _, agg := range aggs {
+		link, ok := agg.SnapshotLinks[characterID]
+		if !ok || link.SnapshotID == nil || *link.SnapshotID == "" {
+			continue
+		}
+		ids = append(ids, *link.SnapshotID)
+	}
+	results := make(map[string]api.CharacterSnapshot)
+	if len(ids) == 0 {
+		return results, nil
+	}
+	snapshots, err := s.snapshotService.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, snapshot := range snapshots {
+		results[snapshot.ID] = snapshot
+	}
+	return results, nil
+}
+
+// sortWeapons orders weapons by most kills, then most matches.
+func sortWeapons(results []api.WeaponPerformance) {
+	slices.SortFunc(results, func(a, b api.WeaponPerformance) int {
+		if c := cmp.Compare(b.Kills, a.Kills); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.Matches, a.Matches)
+	})
+}
+
+func toWeaponPerformance(s weaponStat) api.WeaponPerformance {
+	result := api.WeaponPerformance{
+		ReferenceID:    s.ReferenceID,
+		Display:        s.Display,
+		Matches:        s.Matches,
+		Wins:           s.Wins,
+		Kills:          s.Kills,
+		PrecisionKills: s.PrecisionKills,
+		PrecisionRate:  destiny.Ratio(s.PrecisionKills, s.Kills),
+		KillsPerMatch:  destiny.Ratio(s.Kills, s.Matches),
+		WinRate:        destiny.Ratio(s.Wins, s.Matches),
+	}
+	if s.InstanceID != "" {
+		result.InstanceID = &s.InstanceID
+	}
+	return result
+}
+
+// findItem returns the item in the loadout with the given item hash.
+func findItem(loadout api.Loadout, itemHash int64) (api.ItemSnapshot, bool) {
+	for _, item := range loadout {
+		if item.ItemHash == itemHash {
+			return item, true
+		}
+	}
+	return api.ItemSnapshot{}, false
+}
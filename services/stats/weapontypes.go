@@ -0,0 +1,136 @@
+package stats
+
+import (
+	"cmp"
+	"oneTrick/api"
+	"oneTrick/services/destiny"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// unknownGroup names weapons whose archetype, damage type or tier couldn't be found.
+const unknownGroup = "Unknown"
+
+// itemTiers are the tier names the PGCR prefixes to a weapon's archetype, e.g. "Legendary Hand Cannon".
+var itemTiers = []string{"Exotic", "Legendary", "Rare", "Uncommon", "Common", "Basic"}
+
+// weaponGroup is the running total of the weapons sharing an archetype, damage type or tier. Matches and wins
+// are summed per weapon, the rollups don't keep which matches a weapon was used in.
+type weaponGroup struct {
+	Stat    weaponStat
+	Weapons int
+}
+
+func (s *service) GetWeaponTypePerformance(rollup api.StatsRollup, snapshots []api.CharacterSnapshot, modes []int64) api.WeaponTypeBreakdown {
+	items := make(map[int64]api.BaseItemInfo)
+	for _, snap := range snapshots {
+		for _, item := range snap.Loadout {
+			items[item.ItemHash] = item.ItemProperties.BaseInfo
+		}
+	}
+
+	bucket := bucketFor(rollup, modes)
+	total := weaponStat{}
+	archetypes := make(map[string]*weaponGroup)
+	damageTypes := make(map[string]*weaponGroup)
+	tiers := make(map[string]*weaponGroup)
+	for key, w := range bucket.Weapons {
+		referenceID := w.ReferenceID
+		if referenceID == 0 {
+			referenceID, _ = strconv.ParseInt(key, 10, 64)
+		}
+		archetype, damageType, tier := weaponTypes(items[referenceID], w.Display)
+		for _, g := range []struct {
+			groups map[string]*weaponGroup
+			name   string
+		}{{archetypes, archetype}, {damageTypes, damageType}, {tiers, tier}} {
+			group, ok := g.groups[g.name]
+			if !ok {
+				group = &weaponGroup{}
+				g.groups[g.name] = group
+			}
+			group.Weapons++
+			group.Stat.Matches += w.Matches
+			group.Stat.Wins += w.Wins
+			group.Stat.Kills += w.Kills
+			group.Stat.PrecisionKills += w.PrecisionKills
+		}
+		total.Kills += w.Kills
+		total.PrecisionKills += w.PrecisionKills
+	}
+
+	baseline := api.WeaponBaseline{
+		Matches:        bucket.Totals.Matches,
+		Kills:          total.Kills,
+		PrecisionKills: total.PrecisionKills,
+		PrecisionRate:  destiny.Ratio(total.PrecisionKills, total.Kills),
+		WinRate:        destiny.Ratio(bucket.Totals.Wins, bucket.Totals.Matches),
+	}
+	return api.WeaponTypeBreakdown{
+		Archetypes:  toWeaponGroups(archetypes, baseline),
+		DamageTypes: toWeaponGroups(damageTypes, baseline),
+		Tiers:       toWeaponGroups(tiers, baseline),
+		Baseline:    baseline,
+	}
+}
+
+// weaponTypes returns the archetype, damage type and tier of a weapon. Weapons never captured in a snapshot
+// fall back to the PGCR description, which has no damage type.
+func weaponTypes(info api.BaseItemInfo, display *api.Display) (string, string, string) {
+	archetype, damageType, tier := info.ItemTypeDisplayName, "", info.TierTypeName
+	if info.Damage != nil {
+		damageType = info.Damage.DamageType
+	}
+	if archetype == "" && display != nil {
+		tier, archetype = splitTier(display.Description)
+	}
+	if archetype == "" {
+		archetype = unknownGroup
+	}
+	if damageType == "" {
+		damageType = unknownGroup
+	}
+	if tier == "" {
+		tier = unknownGroup
+	}
+	return archetype, damageType, tier
+}
+
+// splitTier splits a description like "Legendary Hand Cannon" into its tier and archetype.
+func splitTier(description string) (string, string) {
+	for _, tier := range itemTiers {
+		if archetype, ok := strings.CutPrefix(description, tier+" "); ok {
+			return tier, archetype
+		}
+	}
+	return "", description
+}
+
+func toWeaponGroups(groups map[string]*weaponGroup, baseline api.WeaponBaseline) []api.WeaponGroupPerformance {
+	results := make([]api.WeaponGroupPerformance, 0, len(groups))
+	for name, g := range groups {
+		precisionRate := destiny.Ratio(g.Stat.PrecisionKills, g.Stat.Kills)
+		winRate := destiny.Ratio(g.Stat.Wins, g.Stat.Matches)
+		results = append(results, api.WeaponGroupPerformance{
+			Name:                    name,
+			Weapons:                 g.Weapons,
+			WeaponMatches:           g.Stat.Matches,
+			WeaponWins:              g.Stat.Wins,
+			Kills:                   g.Stat.Kills,
+			PrecisionKills:          g.Stat.PrecisionKills,
+			KillsShare:              destiny.Ratio(g.Stat.Kills, baseline.Kills),
+			PrecisionRate:           precisionRate,
+			WinRate:                 winRate,
+			PrecisionRateDifference: precisionRate - baseline.PrecisionRate,
+			WinRateDifference:       winRate - baseline.WinRate,
+		})
+	}
+	slices.SortFunc(results, func(a, b api.WeaponGroupPerformance) int {
+		if c := cmp.Compare(b.Kills, a.Kills); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Name, b.Name)
+	})
+	return results
+}
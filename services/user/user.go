@@ -19,6 +19,8 @@ import (
 
 type Service interface {
 	GetUser(ctx context.Context, ID string) (*User, error)
+	// GetByIDs returns the users with the provided IDs in the order given. IDs without a user are left out.
+	GetByIDs(ctx context.Context, IDs []string) ([]User, error)
 	CreateUser(ctx context.Context, user *User) (*User, error)
 	GetMembershipType(ctx context.Context, userID string, membershipID string) (int64, error)
 	GetFireteam(ctx context.Context, userID string) ([]api.FireteamMember, error)
@@ -30,6 +32,9 @@ type Service interface {
 	GetAll(ctx context.Context) ([]User, error)
 	// GetByCharacterID returns the user that owns the provided characterID. If not found returns (nil, nil).
 	GetByCharacterID(ctx context.Context, characterID string) (*User, error)
+	// GetByCharacterIDs returns the users that own the provided characterIDs keyed by character ID. Characters
+	// without a user are left out.
+	GetByCharacterIDs(ctx context.Context, characterIDs []string) (map[string]User, error)
 	UpdateUserSearch(ctx context.Context) error
 	Search(ctx context.Context, query string, page int) ([]api.SearchUserResult, error)
 }
@@ -99,6 +104,11 @@ func (s *userService) GetUser(ctx context.Context, ID string) (*User, error) {
 	return nil, NotFound
 }
 
+func (s *userService) GetByIDs(ctx context.Context, IDs []string) ([]User, error) {
+	users, _, err := utils.GetByIDs[User](ctx, s.db, userCollection, IDs)
+	return users, err
+}
+
 func (s *userService) CreateUser(ctx context.Context, user *User) (*User, error) {
 	if user == nil {
 		return nil, errors.New("user is nil")
@@ -270,6 +280,28 @@ func (s *userService) GetByCharacterID(ctx context.Context, characterID string)
 	return u, nil
 }
 
+// GetByCharacterIDs returns the users that own the provided characterIDs keyed by character ID. Characters
+// without a user are left out.
+func (s *userService) GetByCharacterIDs(ctx context.Context, characterIDs []string) (map[string]User, error) {
+	users, _, err := utils.GetByArrayContainsAny[User](
+		ctx,
+		s.db.Collection(userCollection).Query,
+		"characterIds",
+		characterIDs,
+		func(u User) []string { return u.CharacterIDs },
+	)
+	if err != nil {
+		return nil, err
+	}
+	results := make(map[string]User, len(characterIDs))
+	for _, u := range users {
+		for _, id := range u.CharacterIDs {
+			results[id] = u
+		}
+	}
+	return results, nil
+}
+
 func (s *userService) UpdateUserSearch(ctx context.Context) error {
 	users, err := s.GetAll(ctx)
 	if err != nil {
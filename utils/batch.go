@@ -0,0 +1,196 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"cloud.google.com/go/firestore"
+)
+
+const (
+	// MaxInFilterValues is the most values Firestore accepts for an `in` filter.
+	MaxInFilterValues = 30
+	// getAllBatchSize is the number of document refs fetched per GetAll call.
+	getAllBatchSize = 100
+	// maxConcurrentBatches limits how many batches are requested from Firestore at once.
+	maxConcurrentBatches = 8
+)
+
+// GetByIDs fetches the documents with the given IDs from a collection using batched, concurrent GetAll calls.
+// Duplicate IDs are fetched once. Results follow the order the IDs were first given in, and IDs without a
+// document are returned in missing, also in input order.
+func GetByIDs[T any](ctx context.Context, db *firestore.Client, collection string, IDs []string) ([]T, []string, error) {
+	ids := unique(IDs)
+	if len(ids) == 0 {
+		return []T{}, []string{}, nil
+	}
+
+	found := make(map[string]T, len(ids))
+	var mu sync.Mutex
+	err := forEachBatch(ids, getAllBatchSize, func(batch []string) error {
+		refs := make([]*firestore.DocumentRef, 0, len(batch))
+		for _, id := range batch {
+			refs = append(refs, db.Collection(collection).Doc(id))
+		}
+		docs, err := db.GetAll(ctx, refs)
+		if err != nil {
+			return err
+		}
+		for _, doc := range docs {
+			if !doc.Exists() {
+				continue
+			}
+			var item T
+			if err := doc.DataTo(&item); err != nil {
+				return fmt.Errorf("failed to convert doc %s: %w", doc.Ref.ID, err)
+			}
+			mu.Lock()
+			found[doc.Ref.ID] = item
+			mu.Unlock()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	results, missing := ordered(ids, found)
+	return results, missing, nil
+}
+
+// GetByFieldIn runs query with an `in` filter on field for every value, splitting the values into
+// batches that fit Firestore's limit and running them concurrently. key returns the field value of a
+// result so results can follow the order of values. Values with no matching document are returned in missing.
+// When several documents share a value only the first one returned is kept.
+func GetByFieldIn[T any](ctx context.Context, query firestore.Query, field string, values []string, key func(T) string) ([]T, []string, error) {
+	ids := unique(values)
+	if len(ids) == 0 {
+		return []T{}, []string{}, nil
+	}
+
+	found := make(map[string]T, len(ids))
+	var mu sync.Mutex
+	err := forEachBatch(ids, MaxInFilterValues, func(batch []string) error {
+		docs, err := query.Where(field, "in", batch).Documents(ctx).GetAll()
+		if err != nil {
+			return err
+		}
+		results, err := GetAllToStructs[T](docs)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, item := range results {
+			k := key(item)
+			if _, ok := found[k]; !ok {
+				found[k] = item
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	results, missing := ordered(ids, found)
+	return results, missing, nil
+}
+
+// GetByArrayContainsAny is GetByFieldIn for an array field, running query with an `array-contains-any` filter on
+// field for every value. keys returns the field's values of a result, which may match several of the values.
+func GetByArrayContainsAny[T any](ctx context.Context, query firestore.Query, field string, values []string, keys func(T) []string) ([]T, []string, error) {
+	ids := unique(values)
+	if len(ids) == 0 {
+		return []T{}, []string{}, nil
+	}
+
+	requested := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		requested[id] = true
+	}
+	found := make(map[string]T, len(ids))
+	var mu sync.Mutex
+	err := forEachBatch(ids, MaxInFilterValues, func(batch []string) error {
+		docs, err := query.Where(field, "array-contains-any", batch).Documents(ctx).GetAll()
+		if err != nil {
+			return err
+		}
+		results, err := GetAllToStructs[T](docs)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, item := range results {
+			for _, k := range keys(item) {
+				if _, ok := found[k]; requested[k] && !ok {
+					found[k] = item
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	results, missing := ordered(ids, found)
+	return results, missing, nil
+}
+
+// forEachBatch splits items into batches of size and runs fn for each concurrently, returning the first error.
+func forEachBatch(items []string, size int, fn func(batch []string) error) error {
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, maxConcurrentBatches)
+	for i := 0; i < len(items); i += size {
+		end := min(i+size, len(items))
+		batch := items[i:end]
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(batch); err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// ordered returns the found items following ids, along with the ids that weren't found.
+func ordered[T any](ids []string, found map[string]T) ([]T, []string) {
+	results := make([]T, 0, len(found))
+	missing := make([]string, 0)
+	for _, id := range ids {
+		item, ok := found[id]
+		if !ok {
+			missing = append(missing, id)
+			continue
+		}
+		results = append(results, item)
+	}
+	return results, missing
+}
+
+func unique(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	results := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == "" || seen[v] {
+			continue
+		}
+		seen[v] = true
+		results = append(results, v)
+	}
+	return results
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestForEachBatch(t *testing.T) {
+	items := make([]string, 0, 65)
+	for i := 0; i < 65; i++ {
+		items = append(items, string(rune('a'+i%26))+string(rune('a'+i/26)))
+	}
+
+	t.Run("splits items into batches", func(t *testing.T) {
+		var mu sync.Mutex
+		sizes := make([]int, 0)
+		seen := make([]string, 0)
+		err := forEachBatch(items, MaxInFilterValues, func(batch []string) error {
+			mu.Lock()
+			defer mu.Unlock()
+			sizes = append(sizes, len(batch))
+			seen = append(seen, batch...)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("forEachBatch() error = %v", err)
+		}
+		sort.Ints(sizes)
+		if want := []int{5, 30, 30}; !reflect.DeepEqual(sizes, want) {
+			t.Errorf("batch sizes = %v, want %v", sizes, want)
+		}
+		if len(seen) != len(items) {
+			t.Errorf("visited %d items, want %d", len(seen), len(items))
+		}
+	})
+
+	t.Run("returns batch error", func(t *testing.T) {
+		want := errors.New("boom")
+		err := forEachBatch(items, MaxInFilterValues, func(batch []string) error {
+			return want
+		})
+		if !errors.Is(err, want) {
+			t.Errorf("forEachBatch() error = %v, want %v", err, want)
+		}
+	})
+}
+
+func TestOrdered(t *testing.T) {
+	ids := unique([]string{"c", "a", "", "b", "a", "d"})
+	if want := []string{"c", "a", "b", "d"}; !reflect.DeepEqual(ids, want) {
+		t.Fatalf("unique() = %v, want %v", ids, want)
+	}
+
+	found := map[string]int{"a": 1, "c": 3}
+	results, missing := ordered(ids, found)
+	if want := []int{3, 1}; !reflect.DeepEqual(results, want) {
+		t.Errorf("ordered() results = %v, want %v", results, want)
+	}
+	if want := []string{"b", "d"}; !reflect.DeepEqual(missing, want) {
+		t.Errorf("ordered() missing = %v, want %v", missing, want)
+	}
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"fmt"
+
+	"cloud.google.com/go/firestore"
+)
+
+// EndBulkWriter flushes and closes the bulk writer, then checks the result of every job. BulkWriter doesn't report
+// failed writes on its own, so the first failure is returned along with how many writes failed.
+func EndBulkWriter(bw *firestore.BulkWriter, jobs []*firestore.BulkWriterJob) error {
+	bw.End()
+	failed := 0
+	var first error
+	for _, job := range jobs {
+		if _, err := job.Results(); err != nil {
+			failed++
+			if first == nil {
+				first = err
+			}
+		}
+	}
+	if first != nil {
+		return fmt.Errorf("%d of %d writes failed: %w", failed, len(jobs), first)
+	}
+	return nil
+}